@@ -20,9 +20,12 @@ import (
 type ConfigurationApiRouter interface {
 	DeleteConfigurationById(http.ResponseWriter, *http.Request)
 	GetConfigurationById(http.ResponseWriter, *http.Request)
+	GetConfigurationStatusById(http.ResponseWriter, *http.Request)
 	GetConfigurations(http.ResponseWriter, *http.Request)
 	PostConfiguration(http.ResponseWriter, *http.Request)
 	PutConfigurationById(http.ResponseWriter, *http.Request)
+	TestConfiguration(http.ResponseWriter, *http.Request)
+	TestConfigurationById(http.ResponseWriter, *http.Request)
 }
 
 // CustomizationApiRouter defines the required methods for binding the api requests to a responses for the CustomizationApi
@@ -32,6 +35,20 @@ type CustomizationApiRouter interface {
 	GetDashboardTemplateByName(http.ResponseWriter, *http.Request)
 }
 
+// DiscoveryApiRouter defines the required methods for binding the api requests to a responses for the DiscoveryApi
+// The DiscoveryApiRouter implementation should parse necessary information from the http request,
+// pass the data to a DiscoveryApiServicer to perform the required actions, then write the service results to the http response.
+type DiscoveryApiRouter interface {
+	PostDiscovery(http.ResponseWriter, *http.Request)
+}
+
+// SchedulerApiRouter defines the required methods for binding the api requests to a responses for the SchedulerApi
+// The SchedulerApiRouter implementation should parse necessary information from the http request,
+// pass the data to a SchedulerApiServicer to perform the required actions, then write the service results to the http response.
+type SchedulerApiRouter interface {
+	GetSchedulerMetrics(http.ResponseWriter, *http.Request)
+}
+
 // VersionApiRouter defines the required methods for binding the api requests to a responses for the VersionApi
 // The VersionApiRouter implementation should parse necessary information from the http request,
 // pass the data to a VersionApiServicer to perform the required actions, then write the service results to the http response.
@@ -40,6 +57,13 @@ type VersionApiRouter interface {
 	GetVersion(http.ResponseWriter, *http.Request)
 }
 
+// WebhookApiRouter defines the required methods for binding the api requests to a responses for the WebhookApi
+// The WebhookApiRouter implementation should parse necessary information from the http request,
+// pass the data to a WebhookApiServicer to perform the required actions, then write the service results to the http response.
+type WebhookApiRouter interface {
+	PostWebhook(http.ResponseWriter, *http.Request)
+}
+
 // ConfigurationApiServicer defines the api actions for the ConfigurationApi service
 // This interface intended to stay up to date with the openapi yaml used to generate it,
 // while the service implementation can be ignored with the .openapi-generator-ignore file
@@ -47,9 +71,12 @@ type VersionApiRouter interface {
 type ConfigurationApiServicer interface {
 	DeleteConfigurationById(context.Context, int64) (ImplResponse, error)
 	GetConfigurationById(context.Context, int64) (ImplResponse, error)
+	GetConfigurationStatusById(context.Context, int64) (ImplResponse, error)
 	GetConfigurations(context.Context) (ImplResponse, error)
 	PostConfiguration(context.Context, Configuration) (ImplResponse, error)
 	PutConfigurationById(context.Context, int64, Configuration) (ImplResponse, error)
+	TestConfiguration(context.Context, Configuration) (ImplResponse, error)
+	TestConfigurationById(context.Context, int64) (ImplResponse, error)
 }
 
 // CustomizationApiServicer defines the api actions for the CustomizationApi service
@@ -60,6 +87,22 @@ type CustomizationApiServicer interface {
 	GetDashboardTemplateByName(context.Context, string, string) (ImplResponse, error)
 }
 
+// DiscoveryApiServicer defines the api actions for the DiscoveryApi service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can be ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type DiscoveryApiServicer interface {
+	PostDiscovery(context.Context, DiscoveryRequest) (ImplResponse, error)
+}
+
+// SchedulerApiServicer defines the api actions for the SchedulerApi service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can be ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type SchedulerApiServicer interface {
+	GetSchedulerMetrics(context.Context) (ImplResponse, error)
+}
+
 // VersionApiServicer defines the api actions for the VersionApi service
 // This interface intended to stay up to date with the openapi yaml used to generate it,
 // while the service implementation can be ignored with the .openapi-generator-ignore file
@@ -68,3 +111,11 @@ type VersionApiServicer interface {
 	GetOpenAPI(context.Context) (ImplResponse, error)
 	GetVersion(context.Context) (ImplResponse, error)
 }
+
+// WebhookApiServicer defines the api actions for the WebhookApi service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can be ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type WebhookApiServicer interface {
+	PostWebhook(context.Context, int64, string, map[string]interface{}) (ImplResponse, error)
+}
@@ -62,6 +62,12 @@ func (c *ConfigurationApiController) Routes() Routes {
 			"/v1/configs/{config-id}",
 			c.GetConfigurationById,
 		},
+		{
+			"GetConfigurationStatusById",
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/status",
+			c.GetConfigurationStatusById,
+		},
 		{
 			"GetConfigurations",
 			strings.ToUpper("Get"),
@@ -80,6 +86,18 @@ func (c *ConfigurationApiController) Routes() Routes {
 			"/v1/configs/{config-id}",
 			c.PutConfigurationById,
 		},
+		{
+			"TestConfiguration",
+			strings.ToUpper("Post"),
+			"/v1/configs/test",
+			c.TestConfiguration,
+		},
+		{
+			"TestConfigurationById",
+			strings.ToUpper("Post"),
+			"/v1/configs/{config-id}/test",
+			c.TestConfigurationById,
+		},
 	}
 }
 
@@ -123,6 +141,26 @@ func (c *ConfigurationApiController) GetConfigurationById(w http.ResponseWriter,
 
 }
 
+// GetConfigurationStatusById - Get the status of a Kentix configuration
+func (c *ConfigurationApiController) GetConfigurationStatusById(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseInt64Parameter(params["config-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	result, err := c.service.GetConfigurationStatusById(r.Context(), configIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
 // GetConfigurations - Get all Kentix configurations
 func (c *ConfigurationApiController) GetConfigurations(w http.ResponseWriter, r *http.Request) {
 	result, err := c.service.GetConfigurations(r.Context())
@@ -190,3 +228,47 @@ func (c *ConfigurationApiController) PutConfigurationById(w http.ResponseWriter,
 	EncodeJSONResponse(result.Body, &result.Code, w)
 
 }
+
+// TestConfiguration - Tests the connection of an unsaved Kentix configuration
+func (c *ConfigurationApiController) TestConfiguration(w http.ResponseWriter, r *http.Request) {
+	configurationParam := Configuration{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&configurationParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertConfigurationRequired(configurationParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.TestConfiguration(r.Context(), configurationParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// TestConfigurationById - Tests the connection of a Kentix configuration
+func (c *ConfigurationApiController) TestConfigurationById(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseInt64Parameter(params["config-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	result, err := c.service.TestConfigurationById(r.Context(), configIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
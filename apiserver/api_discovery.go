@@ -0,0 +1,82 @@
+/*
+ * Kentix app API
+ *
+ * API to access and configure the Kentix app
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// DiscoveryApiController binds http requests to an api service and writes the service results to the http response
+type DiscoveryApiController struct {
+	service      DiscoveryApiServicer
+	errorHandler ErrorHandler
+}
+
+// DiscoveryApiOption for how the controller is set up.
+type DiscoveryApiOption func(*DiscoveryApiController)
+
+// WithDiscoveryApiErrorHandler inject ErrorHandler into controller
+func WithDiscoveryApiErrorHandler(h ErrorHandler) DiscoveryApiOption {
+	return func(c *DiscoveryApiController) {
+		c.errorHandler = h
+	}
+}
+
+// NewDiscoveryApiController creates a default api controller
+func NewDiscoveryApiController(s DiscoveryApiServicer, opts ...DiscoveryApiOption) Router {
+	controller := &DiscoveryApiController{
+		service:      s,
+		errorHandler: DefaultErrorHandler,
+	}
+
+	for _, opt := range opts {
+		opt(controller)
+	}
+
+	return controller
+}
+
+// Routes returns all the api routes for the DiscoveryApiController
+func (c *DiscoveryApiController) Routes() Routes {
+	return Routes{
+		{
+			"PostDiscovery",
+			strings.ToUpper("Post"),
+			"/v1/discovery",
+			c.PostDiscovery,
+		},
+	}
+}
+
+// PostDiscovery - Discovers Kentix devices in a subnet
+func (c *DiscoveryApiController) PostDiscovery(w http.ResponseWriter, r *http.Request) {
+	discoveryRequestParam := DiscoveryRequest{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&discoveryRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertDiscoveryRequestRequired(discoveryRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.PostDiscovery(r.Context(), discoveryRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
@@ -0,0 +1,70 @@
+/*
+ * Kentix app API
+ *
+ * API to access and configure the Kentix app
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"net/http"
+	"strings"
+)
+
+// SchedulerApiController binds http requests to an api service and writes the service results to the http response
+type SchedulerApiController struct {
+	service      SchedulerApiServicer
+	errorHandler ErrorHandler
+}
+
+// SchedulerApiOption for how the controller is set up.
+type SchedulerApiOption func(*SchedulerApiController)
+
+// WithSchedulerApiErrorHandler inject ErrorHandler into controller
+func WithSchedulerApiErrorHandler(h ErrorHandler) SchedulerApiOption {
+	return func(c *SchedulerApiController) {
+		c.errorHandler = h
+	}
+}
+
+// NewSchedulerApiController creates a default api controller
+func NewSchedulerApiController(s SchedulerApiServicer, opts ...SchedulerApiOption) Router {
+	controller := &SchedulerApiController{
+		service:      s,
+		errorHandler: DefaultErrorHandler,
+	}
+
+	for _, opt := range opts {
+		opt(controller)
+	}
+
+	return controller
+}
+
+// Routes returns all the api routes for the SchedulerApiController
+func (c *SchedulerApiController) Routes() Routes {
+	return Routes{
+		{
+			"GetSchedulerMetrics",
+			strings.ToUpper("Get"),
+			"/v1/scheduler",
+			c.GetSchedulerMetrics,
+		},
+	}
+}
+
+// GetSchedulerMetrics - Metrics of the scheduler polling the configurations
+func (c *SchedulerApiController) GetSchedulerMetrics(w http.ResponseWriter, r *http.Request) {
+	result, err := c.service.GetSchedulerMetrics(r.Context())
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
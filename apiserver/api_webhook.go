@@ -0,0 +1,88 @@
+/*
+ * Kentix app API
+ *
+ * API to access and configure the Kentix app
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// WebhookApiController binds http requests to an api service and writes the service results to the http response
+type WebhookApiController struct {
+	service      WebhookApiServicer
+	errorHandler ErrorHandler
+}
+
+// WebhookApiOption for how the controller is set up.
+type WebhookApiOption func(*WebhookApiController)
+
+// WithWebhookApiErrorHandler inject ErrorHandler into controller
+func WithWebhookApiErrorHandler(h ErrorHandler) WebhookApiOption {
+	return func(c *WebhookApiController) {
+		c.errorHandler = h
+	}
+}
+
+// NewWebhookApiController creates a default api controller
+func NewWebhookApiController(s WebhookApiServicer, opts ...WebhookApiOption) Router {
+	controller := &WebhookApiController{
+		service:      s,
+		errorHandler: DefaultErrorHandler,
+	}
+
+	for _, opt := range opts {
+		opt(controller)
+	}
+
+	return controller
+}
+
+// Routes returns all the api routes for the WebhookApiController
+func (c *WebhookApiController) Routes() Routes {
+	return Routes{
+		{
+			"PostWebhook",
+			strings.ToUpper("Post"),
+			"/v1/webhooks/{config-id}",
+			c.PostWebhook,
+		},
+	}
+}
+
+// PostWebhook - Receives a Kentix webhook call
+func (c *WebhookApiController) PostWebhook(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseInt64Parameter(params["config-id"], true)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+
+	secretParam := r.Header.Get("X-Webhook-Secret")
+	bodyParam := map[string]interface{}{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&bodyParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	result, err := c.service.PostWebhook(r.Context(), configIdParam, secretParam, bodyParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
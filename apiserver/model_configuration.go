@@ -35,6 +35,24 @@ type Configuration struct {
 
 	// List of Eliona project ids for which this device should collect data. For each project id all smart devices are automatically created as an asset in Eliona. The mapping between Eliona is stored as an asset mapping in the Kentix app.
 	ProjectIDs *[]string `json:"projectIDs,omitempty"`
+
+	// Shared secret the Kentix device has to send with webhook calls. Webhooks are rejected for this configuration if not set.
+	WebhookSecret *string `json:"webhookSecret,omitempty"`
+
+	// PEM encoded CA certificates to verify the certificate of the device with, in addition to the system CAs
+	CaCertificate *string `json:"caCertificate,omitempty"`
+
+	// SHA-256 fingerprint of the certificate of the device. If set, only this certificate is accepted.
+	CertificateFingerprint *string `json:"certificateFingerprint,omitempty"`
+
+	// Pins the certificate presented by the device on the first connection, if no fingerprint is set
+	TrustOnFirstUse *bool `json:"trustOnFirstUse,omitempty"`
+
+	// Skips the verification of the certificate of the device
+	Insecure *bool `json:"insecure,omitempty"`
+
+	// Number of polls in a row the device has to fail before an offline alarm is raised
+	OfflineAlarmThreshold *int32 `json:"offlineAlarmThreshold,omitempty"`
 }
 
 // AssertConfigurationRequired checks if the required fields are not zero-ed
@@ -0,0 +1,65 @@
+/*
+ * Kentix app API
+ *
+ * API to access and configure the Kentix app
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"time"
+)
+
+// ConfigurationStatus - Status of the latest polls of a Kentix configuration.
+type ConfigurationStatus struct {
+
+	// ID of the configuration
+	ConfigurationId *int64 `json:"configurationId,omitempty"`
+
+	// Serial number of the device of the configuration, as reported by the last successful poll
+	DeviceSerial *string `json:"deviceSerial,omitempty"`
+
+	// Time of the last successful poll
+	LastSuccessAt *time.Time `json:"lastSuccessAt,omitempty"`
+
+	// Error of the last failed poll
+	LastError *string `json:"lastError,omitempty"`
+
+	// Time of the last failed poll
+	LastErrorAt *time.Time `json:"lastErrorAt,omitempty"`
+
+	// Number of failed polls since the last successful poll
+	ConsecutiveFailures int32 `json:"consecutiveFailures"`
+
+	// Duration of the last poll in milliseconds
+	PollDurationMs int32 `json:"pollDurationMs"`
+
+	// Number of assets updated by the last poll
+	AssetsUpdated int32 `json:"assetsUpdated"`
+
+	// State of the circuit breaker which suspends polling of devices failing for a long time
+	CircuitState string `json:"circuitState,omitempty"`
+
+	// Time until polling is suspended by the open circuit breaker
+	CircuitOpenUntil *time.Time `json:"circuitOpenUntil,omitempty"`
+}
+
+// AssertConfigurationStatusRequired checks if the required fields are not zero-ed
+func AssertConfigurationStatusRequired(obj ConfigurationStatus) error {
+	return nil
+}
+
+// AssertRecurseConfigurationStatusRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of ConfigurationStatus (e.g. [][]ConfigurationStatus), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseConfigurationStatusRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aConfigurationStatus, ok := obj.(ConfigurationStatus)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertConfigurationStatusRequired(aConfigurationStatus)
+	})
+}
@@ -0,0 +1,63 @@
+/*
+ * Kentix app API
+ *
+ * API to access and configure the Kentix app
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// ConnectionTestResult - Result of testing the connection to a Kentix device.
+type ConnectionTestResult struct {
+
+	// Whether the device answered
+	Reachable bool `json:"reachable"`
+
+	// Whether the device accepted the API key
+	Authenticated bool `json:"authenticated"`
+
+	// Device type reported by the device
+	Type int32 `json:"type,omitempty"`
+
+	// Eliona asset type used for the device
+	AssetType string `json:"assetType,omitempty"`
+
+	// Serial number of the device
+	Serial string `json:"serial,omitempty"`
+
+	// Firmware version of the device
+	Firmware string `json:"firmware,omitempty"`
+
+	// Time in milliseconds the device needed to answer the device info request
+	LatencyMs int64 `json:"latencyMs"`
+
+	// Why the connection test failed
+	Error string `json:"error,omitempty"`
+
+	// Results of the readers used to collect the data of the detected device type
+	Readers []ReaderTestResult `json:"readers,omitempty"`
+}
+
+// AssertConnectionTestResultRequired checks if the required fields are not zero-ed
+func AssertConnectionTestResultRequired(obj ConnectionTestResult) error {
+	for _, el := range obj.Readers {
+		if err := AssertReaderTestResultRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertRecurseConnectionTestResultRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of ConnectionTestResult (e.g. [][]ConnectionTestResult), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseConnectionTestResultRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aConnectionTestResult, ok := obj.(ConnectionTestResult)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertConnectionTestResultRequired(aConnectionTestResult)
+	})
+}
@@ -0,0 +1,52 @@
+/*
+ * Kentix app API
+ *
+ * API to access and configure the Kentix app
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// DiscoveredDevice - Kentix device found by discovery.
+type DiscoveredDevice struct {
+
+	// Address to access the device
+	Address string `json:"address,omitempty"`
+
+	// Name of the device
+	Name string `json:"name,omitempty"`
+
+	// Device type reported by the device
+	Type int32 `json:"type,omitempty"`
+
+	// Eliona asset type used for the device
+	AssetType string `json:"assetType,omitempty"`
+
+	// Serial number of the device
+	Serial string `json:"serial,omitempty"`
+
+	// Firmware version of the device
+	Firmware string `json:"firmware,omitempty"`
+
+	// ID of the configuration for this device, if there is one
+	ConfigurationId *int64 `json:"configurationId,omitempty"`
+}
+
+// AssertDiscoveredDeviceRequired checks if the required fields are not zero-ed
+func AssertDiscoveredDeviceRequired(obj DiscoveredDevice) error {
+	return nil
+}
+
+// AssertRecurseDiscoveredDeviceRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of DiscoveredDevice (e.g. [][]DiscoveredDevice), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseDiscoveredDeviceRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aDiscoveredDevice, ok := obj.(DiscoveredDevice)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertDiscoveredDeviceRequired(aDiscoveredDevice)
+	})
+}
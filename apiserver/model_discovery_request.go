@@ -0,0 +1,64 @@
+/*
+ * Kentix app API
+ *
+ * API to access and configure the Kentix app
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// DiscoveryRequest - Defines which subnet is scanned for Kentix devices.
+type DiscoveryRequest struct {
+
+	// IPv4 subnet to scan in CIDR notation (at most a /20 network)
+	Cidr string `json:"cidr"`
+
+	// Kentix API key used to access the devices
+	ApiKey string `json:"apiKey,omitempty"`
+
+	// Scheme used to access the devices
+	Scheme string `json:"scheme,omitempty"`
+
+	// Port used to access the devices. The default port of the scheme is used if not set.
+	Port int32 `json:"port,omitempty"`
+
+	// Timeout in seconds for probing a single address
+	RequestTimeout *int32 `json:"requestTimeout,omitempty"`
+
+	// Creates a disabled configuration for each found device which is not configured yet
+	CreateConfigurations bool `json:"createConfigurations,omitempty"`
+
+	// Serial numbers of the devices to create configurations for. Configurations are created for all found devices if empty.
+	SelectedSerials []string `json:"selectedSerials,omitempty"`
+
+	// List of Eliona project ids for the created configurations
+	ProjectIDs *[]string `json:"projectIDs,omitempty"`
+}
+
+// AssertDiscoveryRequestRequired checks if the required fields are not zero-ed
+func AssertDiscoveryRequestRequired(obj DiscoveryRequest) error {
+	elements := map[string]interface{}{
+		"cidr": obj.Cidr,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertRecurseDiscoveryRequestRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of DiscoveryRequest (e.g. [][]DiscoveryRequest), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseDiscoveryRequestRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aDiscoveryRequest, ok := obj.(DiscoveryRequest)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertDiscoveryRequestRequired(aDiscoveryRequest)
+	})
+}
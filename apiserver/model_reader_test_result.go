@@ -0,0 +1,40 @@
+/*
+ * Kentix app API
+ *
+ * API to access and configure the Kentix app
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// ReaderTestResult - Result of a reader used to collect data from a Kentix device.
+type ReaderTestResult struct {
+
+	// Name of the reader
+	Name string `json:"name"`
+
+	// Whether the reader succeeded
+	Success bool `json:"success"`
+
+	// Why the reader failed
+	Error string `json:"error,omitempty"`
+}
+
+// AssertReaderTestResultRequired checks if the required fields are not zero-ed
+func AssertReaderTestResultRequired(obj ReaderTestResult) error {
+	return nil
+}
+
+// AssertRecurseReaderTestResultRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of ReaderTestResult (e.g. [][]ReaderTestResult), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseReaderTestResultRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aReaderTestResult, ok := obj.(ReaderTestResult)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertReaderTestResultRequired(aReaderTestResult)
+	})
+}
@@ -0,0 +1,55 @@
+/*
+ * Kentix app API
+ *
+ * API to access and configure the Kentix app
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// SchedulerMetrics - Metrics of the scheduler polling the configurations. The lag is the time a poll waited for a free worker after it was due.
+type SchedulerMetrics struct {
+
+	// Number of workers polling at the same time
+	Workers int32 `json:"workers"`
+
+	// Number of enabled configurations
+	Configurations int32 `json:"configurations"`
+
+	// Number of polls waiting for a free worker
+	Queued int32 `json:"queued"`
+
+	// Number of polls running
+	Running int32 `json:"running"`
+
+	// Number of polls started since the app started
+	Polls int64 `json:"polls"`
+
+	// Lag of the last poll in milliseconds
+	LastLagMs int64 `json:"lastLagMs"`
+
+	// Maximum lag in milliseconds
+	MaxLagMs int64 `json:"maxLagMs"`
+
+	// Average lag in milliseconds
+	AverageLagMs int64 `json:"averageLagMs"`
+}
+
+// AssertSchedulerMetricsRequired checks if the required fields are not zero-ed
+func AssertSchedulerMetricsRequired(obj SchedulerMetrics) error {
+	return nil
+}
+
+// AssertRecurseSchedulerMetricsRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of SchedulerMetrics (e.g. [][]SchedulerMetrics), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseSchedulerMetricsRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aSchedulerMetrics, ok := obj.(SchedulerMetrics)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertSchedulerMetricsRequired(aSchedulerMetrics)
+	})
+}
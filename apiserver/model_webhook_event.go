@@ -0,0 +1,40 @@
+/*
+ * Kentix app API
+ *
+ * API to access and configure the Kentix app
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// WebhookEvent - Event pushed by a Kentix device.
+type WebhookEvent struct {
+
+	// Kind of the device the event is about
+	Type string `json:"type,omitempty"`
+
+	// Serial number of the device the event is about
+	Serial string `json:"serial,omitempty"`
+
+	// Current values of the device in the format of the Kentix API
+	Data map[string]interface{} `json:"data,omitempty"`
+}
+
+// AssertWebhookEventRequired checks if the required fields are not zero-ed
+func AssertWebhookEventRequired(obj WebhookEvent) error {
+	return nil
+}
+
+// AssertRecurseWebhookEventRequired recursively checks if required fields are not zero-ed in a nested slice.
+// Accepts only nested slice of WebhookEvent (e.g. [][]WebhookEvent), otherwise ErrTypeAssertionError is thrown.
+func AssertRecurseWebhookEventRequired(objSlice interface{}) error {
+	return AssertRecurseInterfaceRequired(objSlice, func(obj interface{}) error {
+		aWebhookEvent, ok := obj.(WebhookEvent)
+		if !ok {
+			return ErrTypeAssertionError
+		}
+		return AssertWebhookEventRequired(aWebhookEvent)
+	})
+}
@@ -16,8 +16,17 @@ import (
 
 	"kentix/apiserver"
 	"kentix/conf"
+	"kentix/kentix"
+	"kentix/webhook"
+
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
 )
 
+// defaultTestTimeout is the timeout in seconds for testing an unsaved configuration, if the
+// configuration defines none.
+const defaultTestTimeout = 10
+
 // ConfigurationApiService is a service that implements the logic for the ConfigurationApiServicer
 // This service should implement the business logic for every endpoint for the ConfigurationApi API.
 // Include any external packages or services that will be required by this service.
@@ -34,6 +43,9 @@ func (s *ConfigurationApiService) GetConfigurations(ctx context.Context) (apiser
 	if err != nil {
 		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
 	}
+	for i := range configs {
+		configs[i] = maskSecrets(configs[i])
+	}
 	return apiserver.Response(http.StatusOK, configs), nil
 }
 
@@ -42,18 +54,33 @@ func (s *ConfigurationApiService) PostConfiguration(ctx context.Context, config
 	if err != nil {
 		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
 	}
-	return apiserver.Response(http.StatusCreated, insertedConfig), nil
+	return apiserver.Response(http.StatusCreated, maskSecrets(insertedConfig)), nil
 }
 
 func (s *ConfigurationApiService) GetConfigurationById(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
 	config, err := conf.GetConfig(ctx, configId)
-	if errors.Is(err, conf.ErrBadRequest) {
-		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	if errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, maskSecrets(*config)), nil
+}
+
+func (s *ConfigurationApiService) GetConfigurationStatusById(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
+	config, err := conf.GetConfig(ctx, configId)
+	if errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
 	}
 	if err != nil {
 		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
 	}
-	return apiserver.Response(http.StatusOK, config), nil
+	status, err := conf.GetConfigStatus(ctx, *config)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, status), nil
 }
 
 func (s *ConfigurationApiService) PutConfigurationById(ctx context.Context, configId int64, config apiserver.Configuration) (apiserver.ImplResponse, error) {
@@ -62,16 +89,92 @@ func (s *ConfigurationApiService) PutConfigurationById(ctx context.Context, conf
 	if err != nil {
 		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
 	}
-	return apiserver.Response(http.StatusCreated, upsertedConfig), nil
+	if err := conf.ResetCircuitBreaker(ctx, configId); err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusCreated, maskSecrets(upsertedConfig)), nil
 }
 
 func (s *ConfigurationApiService) DeleteConfigurationById(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
-	err := conf.DeleteConfig(ctx, configId)
+	config, err := conf.GetConfig(ctx, configId)
+	if errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	registration, err := conf.DeleteConfig(ctx, configId)
 	if errors.Is(err, conf.ErrBadRequest) {
 		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
 	}
 	if err != nil {
 		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
 	}
+	// The webhook is only removed once the configuration is gone. The device might be unreachable,
+	// which must not fail deleting the configuration.
+	if registration != nil {
+		if err := webhook.Remove(ctx, *config, *registration); err != nil {
+			log.Warn("webhook", "removing webhook of configuration %d: %v", configId, err)
+		}
+	}
 	return apiserver.ImplResponse{Code: http.StatusNoContent}, nil
 }
+
+func (s *ConfigurationApiService) TestConfiguration(ctx context.Context, config apiserver.Configuration) (apiserver.ImplResponse, error) {
+	config, err := conf.WithStoredFields(ctx, config)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.Response(http.StatusBadRequest, err.Error()), nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	if config.RequestTimeout == nil {
+		config.RequestTimeout = common.Ptr[int32](defaultTestTimeout)
+	}
+	return apiserver.Response(http.StatusOK, connectionTestResult(kentix.CheckConnection(ctx, config))), nil
+}
+
+func (s *ConfigurationApiService) TestConfigurationById(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
+	config, err := conf.GetConfig(ctx, configId)
+	if errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, connectionTestResult(kentix.CheckConnection(ctx, *config))), nil
+}
+
+func connectionTestResult(check kentix.ConnectionCheck) apiserver.ConnectionTestResult {
+	result := apiserver.ConnectionTestResult{
+		Reachable:     check.Reachable,
+		Authenticated: check.Authenticated,
+		LatencyMs:     check.Latency.Milliseconds(),
+		Error:         check.Error,
+	}
+	if check.Info != nil {
+		result.Type = int32(check.Info.Type)
+		result.AssetType = check.Info.AssetType
+		result.Serial = check.Info.Serial
+		result.Firmware = check.Info.Version.Firmware
+	}
+	for _, reader := range check.Readers {
+		result.Readers = append(result.Readers, apiserver.ReaderTestResult{
+			Name:    reader.Name,
+			Success: reader.Error == "",
+			Error:   reader.Error,
+		})
+	}
+	return result
+}
+
+// maskSecrets hides the API key and the webhook secret of configurations returned by the API.
+func maskSecrets(config apiserver.Configuration) apiserver.Configuration {
+	if config.ApiKey != "" {
+		config.ApiKey = conf.MaskedSecret
+	}
+	if config.WebhookSecret != nil && *config.WebhookSecret != "" {
+		config.WebhookSecret = common.Ptr(conf.MaskedSecret)
+	}
+	return config
+}
@@ -30,7 +30,7 @@ func NewCustomizationApiService() apiserver.CustomizationApiServicer {
 // GetDashboardTemplateByName - Get a full dashboard template
 func (s *CustomizationApiService) GetDashboardTemplateByName(ctx context.Context, dashboardTemplateName string, projectId string) (apiserver.ImplResponse, error) {
 	if dashboardTemplateName == "Kentix devices" {
-		dashboard, err := eliona.KentixDevicesDashboard(projectId)
+		dashboard, err := eliona.KentixDevicesDashboard(ctx, projectId)
 		if err != nil {
 			return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
 		}
@@ -0,0 +1,110 @@
+/*
+ * Kentix app API
+ *
+ * API to access and configure the Kentix app
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiservices
+
+import (
+	"context"
+	"net/http"
+
+	"kentix/apiserver"
+	"kentix/conf"
+	"kentix/kentix"
+
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+)
+
+// defaultDiscoveryTimeout is the timeout in seconds for probing a single address, if the request
+// doesn't define one. It is kept short, because most addresses of a subnet don't respond at all.
+const defaultDiscoveryTimeout = 2
+
+// DiscoveryApiService is a service that implements the logic for the DiscoveryApiServicer
+// This service should implement the business logic for every endpoint for the DiscoveryApi API.
+// Include any external packages or services that will be required by this service.
+type DiscoveryApiService struct {
+}
+
+// NewDiscoveryApiService creates a default api service
+func NewDiscoveryApiService() apiserver.DiscoveryApiServicer {
+	return &DiscoveryApiService{}
+}
+
+// PostDiscovery - Discovers Kentix devices in a subnet
+func (s *DiscoveryApiService) PostDiscovery(ctx context.Context, request apiserver.DiscoveryRequest) (apiserver.ImplResponse, error) {
+	scheme := request.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	if scheme != "http" && scheme != "https" {
+		return apiserver.Response(http.StatusBadRequest, "scheme must be http or https"), nil
+	}
+	timeout := request.RequestTimeout
+	if timeout == nil {
+		timeout = common.Ptr[int32](defaultDiscoveryTimeout)
+	}
+	found, err := kentix.Discover(ctx, request.Cidr, scheme, int(request.Port), apiserver.Configuration{
+		ApiKey:         request.ApiKey,
+		RequestTimeout: timeout,
+	})
+	if err != nil {
+		return apiserver.Response(http.StatusBadRequest, err.Error()), nil
+	}
+
+	configs, err := conf.GetConfigs(ctx)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	devices := []apiserver.DiscoveredDevice{}
+	for _, f := range found {
+		device := apiserver.DiscoveredDevice{
+			Address:         f.Address,
+			Name:            f.Info.Name,
+			Type:            int32(f.Info.Type),
+			AssetType:       f.Info.AssetType,
+			Serial:          f.Info.Serial,
+			Firmware:        f.Info.Version.Firmware,
+			ConfigurationId: configurationIdForAddress(configs, f.Address),
+		}
+		if device.ConfigurationId == nil && request.CreateConfigurations && isSelected(request.SelectedSerials, device.Serial) {
+			config, err := conf.InsertConfig(ctx, apiserver.Configuration{
+				Address:    f.Address,
+				ApiKey:     request.ApiKey,
+				Enable:     common.Ptr(false),
+				ProjectIDs: request.ProjectIDs,
+			})
+			if err != nil {
+				return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+			}
+			device.ConfigurationId = config.Id
+		}
+		devices = append(devices, device)
+	}
+	return apiserver.Response(http.StatusOK, devices), nil
+}
+
+func configurationIdForAddress(configs []apiserver.Configuration, address string) *int64 {
+	for _, config := range configs {
+		if config.Address == address {
+			return config.Id
+		}
+	}
+	return nil
+}
+
+func isSelected(selectedSerials []string, serial string) bool {
+	if len(selectedSerials) == 0 {
+		return true
+	}
+	for _, selected := range selectedSerials {
+		if selected == serial {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,45 @@
+/*
+ * Kentix app API
+ *
+ * API to access and configure the Kentix app
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiservices
+
+import (
+	"context"
+	"net/http"
+
+	"kentix/apiserver"
+	"kentix/scheduler"
+)
+
+// SchedulerApiService is a service that implements the logic for the SchedulerApiServicer
+// This service should implement the business logic for every endpoint for the SchedulerApi API.
+// Include any external packages or services that will be required by this service.
+type SchedulerApiService struct {
+	scheduler *scheduler.Scheduler
+}
+
+// NewSchedulerApiService creates an api service for the scheduler polling the configurations
+func NewSchedulerApiService(scheduler *scheduler.Scheduler) apiserver.SchedulerApiServicer {
+	return &SchedulerApiService{scheduler: scheduler}
+}
+
+// GetSchedulerMetrics - Metrics of the scheduler polling the configurations
+func (s *SchedulerApiService) GetSchedulerMetrics(ctx context.Context) (apiserver.ImplResponse, error) {
+	metrics := s.scheduler.Metrics()
+	return apiserver.Response(http.StatusOK, apiserver.SchedulerMetrics{
+		Workers:        int32(metrics.Workers),
+		Configurations: int32(metrics.Configurations),
+		Queued:         int32(metrics.Queued),
+		Running:        int32(metrics.Running),
+		Polls:          metrics.Polls,
+		LastLagMs:      metrics.LastLag.Milliseconds(),
+		MaxLagMs:       metrics.MaxLag.Milliseconds(),
+		AverageLagMs:   metrics.AverageLag.Milliseconds(),
+	}), nil
+}
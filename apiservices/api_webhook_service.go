@@ -0,0 +1,112 @@
+/*
+ * Kentix app API
+ *
+ * API to access and configure the Kentix app
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiservices
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"kentix/apiserver"
+	"kentix/conf"
+	"kentix/eliona"
+	"kentix/kentix"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// WebhookApiService is a service that implements the logic for the WebhookApiServicer
+// This service should implement the business logic for every endpoint for the WebhookApi API.
+// Include any external packages or services that will be required by this service.
+type WebhookApiService struct {
+}
+
+// NewWebhookApiService creates a default api service
+func NewWebhookApiService() apiserver.WebhookApiServicer {
+	return &WebhookApiService{}
+}
+
+// PostWebhook - Receives a Kentix webhook call
+func (s *WebhookApiService) PostWebhook(ctx context.Context, configId int64, secret string, body map[string]interface{}) (apiserver.ImplResponse, error) {
+	config, err := conf.GetConfig(ctx, configId)
+	if errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	if !validWebhookSecret(*config, secret) {
+		log.Warn("webhook", "Rejected webhook call with invalid secret for configuration %d", configId)
+		return apiserver.ImplResponse{Code: http.StatusUnauthorized}, nil
+	}
+	if !conf.IsConfigEnabled(*config) {
+		return apiserver.ImplResponse{Code: http.StatusNoContent}, nil
+	}
+
+	event, err := webhookEvent(body)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	switch event.Type {
+	case "doorlock":
+		var values kentix.DoorlockValues
+		if err := json.Unmarshal(data, &values); err != nil {
+			return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+		}
+		err = eliona.UpsertAssetData(ctx, *config, event.Serial, kentix.DoorlockValuesData(values))
+	case "sensor":
+		var sensor kentix.AlarmSensor
+		if err := json.Unmarshal(data, &sensor); err != nil {
+			return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+		}
+		sensor.Serial = event.Serial
+		err = eliona.UpsertAssetData(ctx, *config, sensor.Serial, kentix.AlarmSensorStateData(sensor))
+	default:
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if errors.Is(err, eliona.ErrAssetNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.ImplResponse{Code: http.StatusNoContent}, nil
+}
+
+// webhookEvent reads the event from the body of a webhook call. Fields of the event the app doesn't
+// know are ignored, as devices send further fields depending on their firmware.
+func webhookEvent(body map[string]interface{}) (apiserver.WebhookEvent, error) {
+	var event apiserver.WebhookEvent
+	data, err := json.Marshal(body)
+	if err != nil {
+		return event, fmt.Errorf("marshaling webhook call: %v", err)
+	}
+	if err := json.Unmarshal(data, &event); err != nil {
+		return event, fmt.Errorf("unmarshaling webhook event: %v", err)
+	}
+	return event, nil
+}
+
+// validWebhookSecret compares the secrets in constant time, so the secret cannot be guessed by
+// measuring response times.
+func validWebhookSecret(config apiserver.Configuration, secret string) bool {
+	if config.WebhookSecret == nil || *config.WebhookSecret == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(*config.WebhookSecret), []byte(secret)) == 1
+}
@@ -0,0 +1,61 @@
+//  This file is part of the eliona project.
+//  Copyright © 2023 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package apiservices
+
+import (
+	"encoding/json"
+	"kentix/kentix"
+	"os"
+	"testing"
+)
+
+func TestWebhookEvent(t *testing.T) {
+	// The payload of a KXP doorlock event, with fields the app doesn't use.
+	payload, err := os.ReadFile("testdata/webhook_doorlock.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var body map[string]interface{}
+	if err := json.Unmarshal(payload, &body); err != nil {
+		t.Fatal(err)
+	}
+
+	event, err := webhookEvent(body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if event.Type != "doorlock" || event.Serial != "81700228000cdee9" {
+		t.Fatalf("got event %q of device %q, want doorlock of device 81700228000cdee9", event.Type, event.Serial)
+	}
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var values kentix.DoorlockValues
+	if err := json.Unmarshal(data, &values); err != nil {
+		t.Fatalf("unmarshaling doorlock values: %v", err)
+	}
+	if values.LockState == nil || *values.LockState != 0 || values.Battery == nil || *values.Battery != 86 {
+		t.Fatalf("got lock state %v and battery %v, want 0 and 86", values.LockState, values.Battery)
+	}
+}
+
+func TestWebhookEventRejectsWrongTypes(t *testing.T) {
+	_, err := webhookEvent(map[string]interface{}{"type": "doorlock", "serial": 42})
+	if err == nil {
+		t.Fatal("expected error for serial number of wrong type")
+	}
+}
@@ -17,14 +17,24 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"kentix/apiserver"
 	"kentix/apiservices"
 	"kentix/conf"
 	"kentix/eliona"
 	"kentix/kentix"
+	"kentix/lease"
+	"kentix/scheduler"
+	"kentix/webhook"
 	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
 	"github.com/eliona-smart-building-assistant/go-eliona/app"
 	"github.com/eliona-smart-building-assistant/go-utils/db"
 	utilshttp "github.com/eliona-smart-building-assistant/go-utils/http"
@@ -46,10 +56,25 @@ func initialization() {
 		conf.InitConfiguration,
 		eliona.InitEliona,
 	)
+
+	// Brings installations of version 1.0 up to date with the new tables and asset types of 1.1.
+	app.Patch(conn, app.AppName(), "010100",
+		app.ExecSqlFile("conf/init.sql"),
+		eliona.InitEliona,
+	)
+
+	// Encrypts secrets stored in plain text and re-encrypts them after a key rotation.
+	if err := conf.EncryptSecrets(ctx); err != nil {
+		log.Fatal("conf", "Couldn't encrypt secrets: %v", err)
+	}
 }
 
-func collectData() {
-	configs, err := conf.GetConfigs(context.Background())
+func collectData(ctx context.Context) {
+	configs, err := conf.GetConfigs(ctx)
+	if ctx.Err() != nil {
+		// The app is shutting down.
+		return
+	}
 	if err != nil {
 		log.Fatal("conf", "Couldn't read configs from DB: %v", err)
 		return
@@ -59,21 +84,32 @@ func collectData() {
 		return
 	}
 
+	var ownedConfigs []apiserver.Configuration
 	for _, config := range configs {
 		// Skip config if disabled and set inactive
 		if !conf.IsConfigEnabled(config) {
-			if conf.IsConfigActive(config) {
-				conf.SetConfigActiveState(context.Background(), config, false)
+			if conf.IsConfigActive(config) && ownConfig(ctx, config) {
+				conf.SetConfigActiveState(ctx, config, false)
+				if err := webhook.Unregister(ctx, config); err != nil {
+					log.Error("webhook", "unregistering webhook for configuration %d: %v", *config.Id, err)
+				}
+				if err := pollLeases.Release(ctx, *config.Id); err != nil {
+					log.Error("conf", "releasing lease of configuration %d: %v", *config.Id, err)
+				}
 			}
 			continue
 		}
 
+		// Skip config if polled by another app instance
+		if !ownConfig(ctx, config) {
+			continue
+		}
+
 		// Signals that this config is active
 		if !conf.IsConfigActive(config) {
-			conf.SetConfigActiveState(context.Background(), config, true)
+			conf.SetConfigActiveState(ctx, config, true)
 			log.Info("conf", "Collecting initialized with Configuration %d:\n"+
 				"Address: %s\n"+
-				"API Key: %s\n"+
 				"Enable: %t\n"+
 				"Refresh Interval: %d\n"+
 				"Request Timeout: %d\n"+
@@ -81,7 +117,6 @@ func collectData() {
 				"Project IDs: %v\n",
 				*config.Id,
 				config.Address,
-				config.ApiKey,
 				*config.Enable,
 				config.RefreshInterval,
 				*config.RequestTimeout,
@@ -89,67 +124,546 @@ func collectData() {
 				*config.ProjectIDs)
 		}
 
-		// Runs the ReadNode. If the current node is currently running, skip the execution
-		// After the execution sleeps the configured timeout. During this timeout no further
-		// process for this config is started to read the data.
-		common.RunOnceWithParam(func(config apiserver.Configuration) {
-			log.Info("main", "Collecting %d started", *config.Id)
+		ownedConfigs = append(ownedConfigs, config)
+	}
+
+	reloadChangedConfigs(ownedConfigs)
 
-			collectDataForConfig(config)
+	// Polls the owned configurations which are due. Each configuration is only polled by one
+	// worker at a time and waits its refresh interval after a poll finished.
+	pollScheduler.Schedule(ownedConfigs)
+}
+
+// scheduledConfigs are the configurations this app instance scheduled last, by ID.
+var scheduledConfigs = map[int64]apiserver.Configuration{}
 
-			log.Info("main", "Collecting %d finished", *config.Id)
+// reloadChangedConfigs compares the owned configurations with the ones scheduled last. Changed
+// configurations take effect right away instead of after the running poll, no matter through which
+// app instance they were changed. Polls of configurations which were deleted, disabled or taken over
+// are cancelled.
+func reloadChangedConfigs(configs []apiserver.Configuration) {
+	current := make(map[int64]apiserver.Configuration, len(configs))
+	for _, config := range configs {
+		current[*config.Id] = config
+		if previous, ok := scheduledConfigs[*config.Id]; ok && configChanged(previous, config) {
+			log.Info("conf", "Configuration %d changed", *config.Id)
+			reloadConfig(*config.Id)
+		}
+	}
+	for configId := range scheduledConfigs {
+		if _, ok := current[configId]; !ok {
+			reloadConfig(configId)
+		}
+	}
+	scheduledConfigs = current
+}
+
+// configChanged tells whether the configuration was changed since it was scheduled. Changes the app
+// makes itself are ignored: setting the configuration active, generating the webhook secret and
+// pinning the certificate on first use.
+func configChanged(previous apiserver.Configuration, current apiserver.Configuration) bool {
+	previous.Active, current.Active = nil, nil
+	previous.WebhookSecret, current.WebhookSecret = nil, nil
+	if previous.CertificateFingerprint == nil || *previous.CertificateFingerprint == "" {
+		previous.CertificateFingerprint = current.CertificateFingerprint
+	}
+	return !reflect.DeepEqual(previous, current)
+}
+
+// reloadConfig cancels the running poll of the configuration and drops its connections, so that it is
+// polled again with the stored settings.
+func reloadConfig(configId int64) {
+	pollScheduler.Reload(configId)
+	kentix.ForgetClients(configId)
+	forgetAssetDevices(configId)
+}
 
-			time.Sleep(time.Second * time.Duration(config.RefreshInterval))
-		}, config, *config.Id)
+// ownConfig tells whether this app instance polls the configuration.
+func ownConfig(ctx context.Context, config apiserver.Configuration) bool {
+	owned, err := pollLeases.Own(ctx, *config.Id)
+	if err != nil && ctx.Err() == nil {
+		log.Error("conf", "acquiring lease of configuration %d: %v", *config.Id, err)
 	}
+	return owned
 }
 
-func collectDataForConfig(config apiserver.Configuration) {
-	deviceInfo, err := kentix.GetDeviceInfo(config)
+// defaultPollWorkers is the number of configurations polled at the same time, if POLL_WORKERS is not set.
+const defaultPollWorkers = 10
+
+// defaultPollLeaseTtl is the time after which the configurations of an app instance which died are
+// taken over by the other instances, if POLL_LEASE_TTL is not set.
+const defaultPollLeaseTtl = 30 * time.Second
+
+var pollScheduler *scheduler.Scheduler
+
+var pollLeases *lease.Leases
+
+// startScheduler starts the workers polling the configurations. The polls are cancelled together
+// with the given context.
+func startScheduler(ctx context.Context) {
+	workers, err := strconv.Atoi(common.Getenv("POLL_WORKERS", strconv.Itoa(defaultPollWorkers)))
+	if err != nil {
+		log.Fatal("main", "Invalid POLL_WORKERS: %v", err)
+	}
+	pollScheduler = scheduler.New(workers, pollConfig)
+	pollScheduler.Start(ctx)
+
+	leaseTtl, err := time.ParseDuration(common.Getenv("POLL_LEASE_TTL", defaultPollLeaseTtl.String()))
 	if err != nil {
-		log.Error("kentix", "getting device info: %v", err)
+		log.Fatal("main", "Invalid POLL_LEASE_TTL: %v", err)
+	}
+	// Polls of configurations taken over by another instance are cancelled, and as they are no
+	// longer scheduled, not repeated.
+	pollLeases = lease.New(leaseTtl, pollScheduler.Reload)
+
+}
+
+func pollConfig(ctx context.Context, config apiserver.Configuration) {
+	log.Info("main", "Collecting %d started", *config.Id)
+
+	collectDataForConfig(ctx, config)
+
+	log.Info("main", "Collecting %d finished", *config.Id)
+}
+
+func collectDataForConfig(ctx context.Context, config apiserver.Configuration) {
+	status, err := conf.GetConfigStatus(ctx, config)
+	if err != nil {
+		log.Error("conf", "getting status of config %d: %v", *config.Id, err)
+	} else if status.CircuitState == conf.CircuitOpen {
+		log.Debug("kentix", "Skipping config %d until %v after %d failed polls", *config.Id, *status.CircuitOpenUntil, status.ConsecutiveFailures)
 		return
 	}
 
-	if err := eliona.CreateAssetsIfNecessary(config, *deviceInfo); err != nil {
-		log.Error("eliona", "creating assets: %v", err)
+	start := time.Now()
+	deviceSerial, assetsUpdated, err := pollDevice(ctx, config)
+	if ctx.Err() != nil {
+		// The configuration changed or the app is shutting down. Neither is a failure of the device.
+		log.Info("main", "Collecting %d cancelled", *config.Id)
 		return
 	}
+	if err != nil {
+		log.Error("kentix", "collecting data for config %d: %v", *config.Id, err)
+	}
+	if err := conf.SetPollResult(ctx, config, deviceSerial, time.Since(start), assetsUpdated, err); err != nil {
+		log.Error("conf", "setting poll result for config %d: %v", *config.Id, err)
+	}
+}
+
+// pollDevice collects the data of the device and its slaves and returns the serial number of the
+// device and the number of assets updated.
+func pollDevice(ctx context.Context, config apiserver.Configuration) (string, int, error) {
+	config, err := pinCertificateIfNecessary(ctx, config)
+	if err != nil {
+		return "", 0, fmt.Errorf("pinning certificate: %v", err)
+	}
+	if ctx.Err() != nil {
+		return "", 0, ctx.Err()
+	}
 
-	if err := eliona.UpsertDeviceInfo(config, *deviceInfo); err != nil {
-		log.Error("eliona", "inserting device info: %v", err)
+	deviceInfo, err := kentix.GetDeviceInfo(ctx, config)
+	if ctx.Err() != nil {
+		return "", 0, ctx.Err()
+	}
+	if err != nil {
+		setDeviceOffline(ctx, config)
+		return "", 0, fmt.Errorf("getting device info: %v", err)
+	}
+
+	if err := eliona.CreateAssetsIfNecessary(ctx, config, *deviceInfo); err != nil {
+		return deviceInfo.Serial, 0, fmt.Errorf("creating assets: %v", err)
+	}
+
+	if err := eliona.UpsertDeviceInfo(ctx, config, *deviceInfo); err != nil {
+		return deviceInfo.Serial, 0, fmt.Errorf("inserting device info: %v", err)
+	}
+	updateConnectivity(ctx, config, deviceInfo.Serial, 0)
+	assetsUpdated := 1
+
+	// Registering is retried on each poll until it succeeded, e.g. if the device was unreachable when
+	// the configuration was enabled. A registered webhook is only looked up in the database.
+	if err := webhook.Register(ctx, config); err != nil {
+		log.Error("webhook", "registering webhook for configuration %d: %v", *config.Id, err)
+	}
+
+	updated, err := collectDeviceData(ctx, config, *deviceInfo)
+	assetsUpdated += updated
+	if err != nil {
+		return deviceInfo.Serial, assetsUpdated, err
+	}
+
+	if deviceInfo.MasterSlave.IsMaster() && ctx.Err() == nil {
+		assetsUpdated += collectSlaves(ctx, config, *deviceInfo)
+	}
+	return deviceInfo.Serial, assetsUpdated, nil
+}
+
+// defaultOfflineAlarmThreshold is used for configurations without a valid offline alarm threshold.
+const defaultOfflineAlarmThreshold = 3
+
+// updateConnectivity writes to the asset of the device how many polls in a row it didn't answer.
+func updateConnectivity(ctx context.Context, config apiserver.Configuration, deviceSerial string, failedPolls int32) {
+	threshold := int32(defaultOfflineAlarmThreshold)
+	if config.OfflineAlarmThreshold != nil && *config.OfflineAlarmThreshold > 0 {
+		threshold = *config.OfflineAlarmThreshold
+	}
+	if err := eliona.UpsertConnectivity(ctx, config, deviceSerial, failedPolls, threshold); err != nil {
+		log.Error("eliona", "updating connectivity of device '%s': %v", deviceSerial, err)
+	}
+}
+
+// setDeviceOffline marks the device of the configuration offline. The device doesn't answer, so its
+// serial number is taken from the last successful poll. The failed polls are the ones recorded in
+// the status of the configuration plus the current one, which is recorded after the poll.
+func setDeviceOffline(ctx context.Context, config apiserver.Configuration) {
+	status, err := conf.GetConfigStatus(ctx, config)
+	if err != nil {
+		log.Error("conf", "getting status of config %d: %v", *config.Id, err)
+		return
+	}
+	if status.DeviceSerial == nil {
+		// The device never answered, so there is no asset yet.
+		return
+	}
+	updateConnectivity(ctx, config, *status.DeviceSerial, status.ConsecutiveFailures+1)
+	setSlavesOffline(ctx, config)
+}
+
+// setSlavesOffline counts a failed poll for each known slave of the configuration, as they can't be
+// polled while their master doesn't answer.
+func setSlavesOffline(ctx context.Context, config apiserver.Configuration) {
+	serials, err := conf.GetSlaveSerials(ctx, config)
+	if err != nil {
+		log.Error("conf", "getting slaves of config %d: %v", *config.Id, err)
 		return
 	}
+	for _, serial := range serials {
+		updateSlaveConnectivity(ctx, config, serial, false)
+	}
+}
 
-	switch deviceInfo.AssetType {
-	case kentix.AlarmManagerAssetType:
-	case kentix.AccessPointAssetType:
-		doorlocks, err := kentix.GetAccessPointReadings(config)
+// updateSlaveConnectivity records whether the slave answered the poll and writes it to its asset.
+func updateSlaveConnectivity(ctx context.Context, config apiserver.Configuration, serial string, online bool) {
+	failedPolls, err := conf.SetSlavePollResult(ctx, config, serial, online)
+	if err != nil {
+		log.Error("conf", "setting poll result for slave '%s': %v", serial, err)
+		return
+	}
+	updateConnectivity(ctx, config, serial, failedPolls)
+}
+
+// pinCertificateIfNecessary stores the fingerprint of the certificate presented by the device if the
+// configuration trusts on first use and no fingerprint is pinned yet.
+func pinCertificateIfNecessary(ctx context.Context, config apiserver.Configuration) (apiserver.Configuration, error) {
+	if config.TrustOnFirstUse == nil || !*config.TrustOnFirstUse {
+		return config, nil
+	}
+	if config.Insecure == nil || *config.Insecure {
+		return config, nil
+	}
+	if config.CertificateFingerprint != nil && *config.CertificateFingerprint != "" {
+		return config, nil
+	}
+	fingerprint, err := kentix.GetCertificateFingerprint(ctx, config)
+	if err != nil {
+		return config, fmt.Errorf("getting certificate fingerprint: %v", err)
+	}
+	if err := conf.SetCertificateFingerprint(ctx, config, fingerprint); err != nil {
+		return config, fmt.Errorf("storing certificate fingerprint: %v", err)
+	}
+	log.Info("kentix", "Pinned certificate %s for config %d", fingerprint, *config.Id)
+	config.CertificateFingerprint = &fingerprint
+	return config, nil
+}
+
+// collectSlaves collects the data of all slaves of a master device. The slaves are collected with
+// the configuration of the master and their assets are created as children of the master asset.
+// A failing slave doesn't fail the master, so it is only logged.
+func collectSlaves(ctx context.Context, config apiserver.Configuration, master kentix.DeviceInfo) int {
+	slaves, err := kentix.GetSlaves(ctx, config)
+	if err != nil {
+		log.Error("kentix", "getting slaves of device '%s': %v", master.Serial, err)
+		setSlavesOffline(ctx, config)
+		return 0
+	}
+	assetsUpdated := 0
+	for _, slave := range slaves {
+		if ctx.Err() != nil {
+			break
+		}
+		slaveConfig, err := slaveConfiguration(ctx, config, slave)
+		if err != nil {
+			log.Error("kentix", "creating configuration for slave '%s': %v", slave.Serial, err)
+			updateSlaveConnectivity(ctx, config, slave.Serial, false)
+			continue
+		}
+		deviceInfo, err := kentix.GetDeviceInfo(ctx, slaveConfig)
 		if err != nil {
-			log.Error("kentix", "getting AccessPoint readings: %v", err)
+			log.Error("kentix", "getting device info of slave '%s': %v", slave.Serial, err)
+			updateSlaveConnectivity(ctx, slaveConfig, slave.Serial, false)
+			continue
+		}
+		if err := eliona.CreateSlaveAssetsIfNecessary(ctx, slaveConfig, *deviceInfo, master.Serial); err != nil {
+			log.Error("eliona", "creating slave assets: %v", err)
+			continue
+		}
+		if err := eliona.UpsertDeviceInfo(ctx, slaveConfig, *deviceInfo); err != nil {
+			log.Error("eliona", "inserting slave device info: %v", err)
+			continue
+		}
+		updateSlaveConnectivity(ctx, slaveConfig, deviceInfo.Serial, true)
+		assetsUpdated++
+		updated, err := collectDeviceData(ctx, slaveConfig, *deviceInfo)
+		assetsUpdated += updated
+		if err != nil {
+			log.Error("kentix", "collecting data of slave '%s': %v", slave.Serial, err)
+		}
+	}
+	return assetsUpdated
+}
+
+// slaveConfiguration derives the configuration to access a slave from the configuration of its
+// master. Slaves of a master with pinned certificate are pinned on first use.
+func slaveConfiguration(ctx context.Context, config apiserver.Configuration, slave kentix.Slave) (apiserver.Configuration, error) {
+	slaveConfig, err := kentix.SlaveConfiguration(config, slave)
+	if err != nil {
+		return slaveConfig, err
+	}
+	if slaveConfig.TrustOnFirstUse == nil || !*slaveConfig.TrustOnFirstUse {
+		return slaveConfig, nil
+	}
+	fingerprint, err := conf.GetSlaveFingerprint(ctx, config, slave.Serial)
+	if err != nil {
+		return slaveConfig, fmt.Errorf("getting certificate fingerprint: %v", err)
+	}
+	if fingerprint == nil {
+		pinned, err := kentix.GetCertificateFingerprint(ctx, slaveConfig)
+		if err != nil {
+			return slaveConfig, fmt.Errorf("getting certificate fingerprint: %v", err)
+		}
+		if err := conf.SetSlaveFingerprint(ctx, config, slave.Serial, pinned); err != nil {
+			return slaveConfig, fmt.Errorf("storing certificate fingerprint: %v", err)
+		}
+		log.Info("kentix", "Pinned certificate %s for slave '%s' of config %d", pinned, slave.Serial, *config.Id)
+		fingerprint = &pinned
+	}
+	slaveConfig.CertificateFingerprint = fingerprint
+	return slaveConfig, nil
+}
+
+// polledDevice is a device polled through a configuration, which is either the device of the
+// configuration or one of its slaves.
+type polledDevice struct {
+	config apiserver.Configuration
+	serial string
+}
+
+type assetKey struct {
+	configId   int64
+	identifier string
+}
+
+// assetDevices remembers the device each asset was collected from by the last poll, so that
+// commands are sent to the device right away instead of looking up the master/slave setup first.
+var assetDevices sync.Map
+
+func rememberAssetDevices(config apiserver.Configuration, deviceSerial string, assets []kentix.Asset) {
+	for _, asset := range assets {
+		assetDevices.Store(assetKey{*config.Id, asset.Identifier}, polledDevice{config: config, serial: deviceSerial})
+	}
+}
+
+// forgetAssetDevices drops the devices remembered for the configuration, e.g. after it was changed.
+func forgetAssetDevices(configId int64) {
+	assetDevices.Range(func(key, _ any) bool {
+		if key.(assetKey).configId == configId {
+			assetDevices.Delete(key)
+		}
+		return true
+	})
+}
+
+// commandDevices returns the devices a command for the asset might be meant for. Usually this is the
+// device the asset was collected from by the last poll. Assets not polled yet since the app started
+// might belong to the device of the configuration or any of its slaves.
+func commandDevices(ctx context.Context, config apiserver.Configuration, identifier string) ([]polledDevice, error) {
+	if device, ok := assetDevices.Load(assetKey{*config.Id, identifier}); ok {
+		return []polledDevice{device.(polledDevice)}, nil
+	}
+	deviceInfo, err := kentix.GetDeviceInfo(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("getting device info: %v", err)
+	}
+	devices := []polledDevice{{config: config, serial: deviceInfo.Serial}}
+	if !deviceInfo.MasterSlave.IsMaster() {
+		return devices, nil
+	}
+	slaves, err := kentix.GetSlaves(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("getting slaves: %v", err)
+	}
+	for _, slave := range slaves {
+		slaveConfig, err := slaveConfiguration(ctx, config, slave)
+		if err != nil {
+			log.Error("kentix", "creating configuration for slave '%s': %v", slave.Serial, err)
+			continue
+		}
+		devices = append(devices, polledDevice{config: slaveConfig, serial: slave.Serial})
+	}
+	return devices, nil
+}
+
+// collectDeviceData collects the data specific to the device type through its driver and returns
+// the number of assets updated, also if collecting fails halfway.
+func collectDeviceData(ctx context.Context, config apiserver.Configuration, deviceInfo kentix.DeviceInfo) (int, error) {
+	driver, err := kentix.LookupDriver(deviceInfo.Type)
+	if err != nil {
+		return 0, err
+	}
+	accessLogCursor, err := conf.GetAccessLogCursor(ctx, config)
+	if err != nil {
+		return 0, fmt.Errorf("getting access log cursor: %v", err)
+	}
+	// The assets collected before a failure are written anyway.
+	assets, collectErr := driver.Collect(ctx, config, deviceInfo, kentix.PollState{AccessLogCursor: accessLogCursor})
+	rememberAssetDevices(config, deviceInfo.Serial, assets)
+	assetsUpdated, err := eliona.UpsertAssets(ctx, config, assets)
+	// The cursor is only advanced for access events which were written.
+	for _, asset := range assets[:assetsUpdated] {
+		if asset.AccessLogCursor == nil {
+			continue
+		}
+		if err := conf.SetAccessLogCursor(ctx, config, *asset.AccessLogCursor); err != nil {
+			return assetsUpdated, fmt.Errorf("setting access log cursor: %v", err)
+		}
+	}
+	if err != nil {
+		return assetsUpdated, fmt.Errorf("inserting %s data: %v", driver.AssetType(), err)
+	}
+	if collectErr != nil {
+		return assetsUpdated, collectErr
+	}
+	return assetsUpdated, nil
+}
+
+// listenForOutputChanges reacts to changes of output attributes made in Eliona. The connection to
+// Eliona is reestablished whenever it breaks, so it only returns once the context is cancelled.
+func listenForOutputChanges(ctx context.Context) {
+	outputs := eliona.ListenForOutputChanges()
+	for {
+		var output api.Data
+		select {
+		case <-ctx.Done():
 			return
+		case output = <-outputs:
 		}
-		for _, doorlock := range doorlocks {
-			if err := eliona.CreateDoorlockAssetsIfNecessary(config, doorlock, deviceInfo.Serial); err != nil {
-				log.Error("eliona", "creating doorlock assets: %v", err)
-				return
-			}
-			if err := eliona.UpsertDoorlockData(config, doorlock); err != nil {
-				log.Error("eliona", "inserting doorlock data: %v", err)
-				return
-			}
+		if output.ClientReference.Get() != nil && *output.ClientReference.Get() == eliona.ClientReference {
+			// Output written by this app itself.
+			continue
+		}
+		switch output.GetAssetTypeName() {
+		case kentix.DoorlockAssetType:
+			openDoorlockIfRequested(ctx, output)
+		case kentix.AlarmZoneAssetType:
+			armAlarmZone(ctx, output)
+		}
+	}
+}
+
+func openDoorlockIfRequested(ctx context.Context, output api.Data) {
+	if command, ok := output.Data["open_command"].(float64); !ok || command != 1 {
+		return
+	}
+	sensor, err := conf.GetSensorByAssetId(ctx, output.AssetId)
+	if err != nil {
+		log.Error("conf", "getting sensor for asset %d: %v", output.AssetId, err)
+		return
+	}
+	if sensor == nil {
+		return
+	}
+	if !ownConfig(ctx, sensor.Configuration) {
+		// The command is sent by the app instance polling the configuration.
+		return
+	}
+	log.Info("kentix", "Opening doorlock '%s' of configuration %d", sensor.SerialNumber, *sensor.Configuration.Id)
+	openErr := openDoorlock(ctx, sensor.Configuration, sensor.SerialNumber)
+	if openErr != nil {
+		log.Error("kentix", "opening doorlock '%s': %v", sensor.SerialNumber, openErr)
+	}
+	if err := eliona.UpsertDoorlockOpenResult(ctx, output.AssetId, openErr); err != nil {
+		log.Error("eliona", "writing doorlock open result: %v", err)
+	}
+}
+
+// openDoorlock opens the doorlock on the device it is connected to, which is either the device of
+// the configuration or one of its slaves.
+func openDoorlock(ctx context.Context, config apiserver.Configuration, serial string) error {
+	devices, err := commandDevices(ctx, config, serial)
+	if err != nil {
+		return err
+	}
+	for _, device := range devices {
+		err := kentix.OpenDoorlock(ctx, device.config, serial)
+		if !errors.Is(err, kentix.ErrNotFound) {
+			return err
 		}
-	case kentix.MultiSensorAssetType:
-		sensor, err := kentix.GetMultiSensorReadings(config)
+	}
+	return fmt.Errorf("doorlock '%s': %w", serial, kentix.ErrNotFound)
+}
+
+func armAlarmZone(ctx context.Context, output api.Data) {
+	command, ok := output.Data["arm_command"].(float64)
+	if !ok {
+		return
+	}
+	sensor, err := conf.GetSensorByAssetId(ctx, output.AssetId)
+	if err != nil {
+		log.Error("conf", "getting sensor for asset %d: %v", output.AssetId, err)
+		return
+	}
+	if sensor == nil {
+		return
+	}
+	if !ownConfig(ctx, sensor.Configuration) {
+		// The command is sent by the app instance polling the configuration.
+		return
+	}
+	devices, err := commandDevices(ctx, sensor.Configuration, sensor.SerialNumber)
+	if err != nil {
+		log.Error("kentix", "getting devices of configuration %d: %v", *sensor.Configuration.Id, err)
+		return
+	}
+	// Alarm zones are identified by the serial number of the device they are defined on.
+	for _, device := range devices {
+		if !strings.HasPrefix(sensor.SerialNumber, device.serial+"_") {
+			continue
+		}
+		config := device.config
+		zones, err := kentix.GetAlarmZones(ctx, config)
 		if err != nil {
-			log.Error("kentix", "getting MultiSensor readings: %v", err)
+			log.Error("kentix", "getting AlarmManager alarm zones: %v", err)
 			return
 		}
-		if err := eliona.UpsertMultiSensorData(config, *sensor); err != nil {
-			log.Error("eliona", "inserting MultiSensor data: %v", err)
+		for _, zone := range zones {
+			if kentix.AlarmZoneIdentifier(device.serial, zone) != sensor.SerialNumber {
+				continue
+			}
+			armed := command == 1
+			log.Info("kentix", "Setting alarm zone '%s' of configuration %d armed: %t", zone.Name, *config.Id, armed)
+			if err := kentix.SetAlarmZoneArmed(ctx, config, zone.ID, armed); err != nil {
+				log.Error("kentix", "arming alarm zone '%s': %v", zone.Name, err)
+			} else {
+				zone.Armed = kentix.Flag(armed)
+			}
+			// Writes back the resulting state, which also resets the output if arming failed.
+			if err := eliona.UpsertAssetData(ctx, config, kentix.AlarmZoneIdentifier(device.serial, zone), kentix.AlarmZoneData(zone)); err != nil {
+				log.Error("eliona", "inserting alarm zone data: %v", err)
+			}
 			return
 		}
 	}
+	log.Warn("kentix", "alarm zone '%s' not found", sensor.SerialNumber)
 }
 
 // listenApiRequests starts an API server and listen for API requests.
@@ -160,6 +674,9 @@ func listenApiRequests() {
 			apiserver.NewConfigurationApiController(apiservices.NewConfigurationApiService()),
 			apiserver.NewVersionApiController(apiservices.NewVersionApiService()),
 			apiserver.NewCustomizationApiController(apiservices.NewCustomizationApiService()),
+			apiserver.NewDiscoveryApiController(apiservices.NewDiscoveryApiService()),
+			apiserver.NewWebhookApiController(apiservices.NewWebhookApiService()),
+			apiserver.NewSchedulerApiController(apiservices.NewSchedulerApiService(pollScheduler)),
 		)))
 	log.Fatal("main", "Error in API Server: %v", err)
 }
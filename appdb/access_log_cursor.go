@@ -0,0 +1,1238 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// AccessLogCursor is an object representing the database table.
+type AccessLogCursor struct {
+	ConfigurationID int64 `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	LastEventID     int64 `boil:"last_event_id" json:"last_event_id" toml:"last_event_id" yaml:"last_event_id"`
+
+	R *accessLogCursorR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L accessLogCursorL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AccessLogCursorColumns = struct {
+	ConfigurationID string
+	LastEventID     string
+}{
+	ConfigurationID: "configuration_id",
+	LastEventID:     "last_event_id",
+}
+
+var AccessLogCursorTableColumns = struct {
+	ConfigurationID string
+	LastEventID     string
+}{
+	ConfigurationID: "access_log_cursor.configuration_id",
+	LastEventID:     "access_log_cursor.last_event_id",
+}
+
+// Generated where
+
+type whereHelperint64 struct{ field string }
+
+func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var AccessLogCursorWhere = struct {
+	ConfigurationID whereHelperint64
+	LastEventID     whereHelperint64
+}{
+	ConfigurationID: whereHelperint64{field: "\"kentix\".\"access_log_cursor\".\"configuration_id\""},
+	LastEventID:     whereHelperint64{field: "\"kentix\".\"access_log_cursor\".\"last_event_id\""},
+}
+
+// AccessLogCursorRels is where relationship names are stored.
+var AccessLogCursorRels = struct {
+	Configuration string
+}{
+	Configuration: "Configuration",
+}
+
+// accessLogCursorR is where relationships are stored.
+type accessLogCursorR struct {
+	Configuration *Configuration `boil:"Configuration" json:"Configuration" toml:"Configuration" yaml:"Configuration"`
+}
+
+// NewStruct creates a new relationship struct
+func (*accessLogCursorR) NewStruct() *accessLogCursorR {
+	return &accessLogCursorR{}
+}
+
+func (r *accessLogCursorR) GetConfiguration() *Configuration {
+	if r == nil {
+		return nil
+	}
+	return r.Configuration
+}
+
+// accessLogCursorL is where Load methods for each relationship are stored.
+type accessLogCursorL struct{}
+
+var (
+	accessLogCursorAllColumns            = []string{"configuration_id", "last_event_id"}
+	accessLogCursorColumnsWithoutDefault = []string{"configuration_id"}
+	accessLogCursorColumnsWithDefault    = []string{"last_event_id"}
+	accessLogCursorPrimaryKeyColumns     = []string{"configuration_id"}
+	accessLogCursorGeneratedColumns      = []string{}
+)
+
+type (
+	// AccessLogCursorSlice is an alias for a slice of pointers to AccessLogCursor.
+	// This should almost always be used instead of []AccessLogCursor.
+	AccessLogCursorSlice []*AccessLogCursor
+	// AccessLogCursorHook is the signature for custom AccessLogCursor hook methods
+	AccessLogCursorHook func(context.Context, boil.ContextExecutor, *AccessLogCursor) error
+
+	accessLogCursorQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	accessLogCursorType                 = reflect.TypeOf(&AccessLogCursor{})
+	accessLogCursorMapping              = queries.MakeStructMapping(accessLogCursorType)
+	accessLogCursorPrimaryKeyMapping, _ = queries.BindMapping(accessLogCursorType, accessLogCursorMapping, accessLogCursorPrimaryKeyColumns)
+	accessLogCursorInsertCacheMut       sync.RWMutex
+	accessLogCursorInsertCache          = make(map[string]insertCache)
+	accessLogCursorUpdateCacheMut       sync.RWMutex
+	accessLogCursorUpdateCache          = make(map[string]updateCache)
+	accessLogCursorUpsertCacheMut       sync.RWMutex
+	accessLogCursorUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var accessLogCursorAfterSelectMu sync.Mutex
+var accessLogCursorAfterSelectHooks []AccessLogCursorHook
+
+var accessLogCursorBeforeInsertMu sync.Mutex
+var accessLogCursorBeforeInsertHooks []AccessLogCursorHook
+var accessLogCursorAfterInsertMu sync.Mutex
+var accessLogCursorAfterInsertHooks []AccessLogCursorHook
+
+var accessLogCursorBeforeUpdateMu sync.Mutex
+var accessLogCursorBeforeUpdateHooks []AccessLogCursorHook
+var accessLogCursorAfterUpdateMu sync.Mutex
+var accessLogCursorAfterUpdateHooks []AccessLogCursorHook
+
+var accessLogCursorBeforeDeleteMu sync.Mutex
+var accessLogCursorBeforeDeleteHooks []AccessLogCursorHook
+var accessLogCursorAfterDeleteMu sync.Mutex
+var accessLogCursorAfterDeleteHooks []AccessLogCursorHook
+
+var accessLogCursorBeforeUpsertMu sync.Mutex
+var accessLogCursorBeforeUpsertHooks []AccessLogCursorHook
+var accessLogCursorAfterUpsertMu sync.Mutex
+var accessLogCursorAfterUpsertHooks []AccessLogCursorHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *AccessLogCursor) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accessLogCursorAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *AccessLogCursor) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accessLogCursorBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *AccessLogCursor) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accessLogCursorAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *AccessLogCursor) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accessLogCursorBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *AccessLogCursor) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accessLogCursorAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *AccessLogCursor) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accessLogCursorBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *AccessLogCursor) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accessLogCursorAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *AccessLogCursor) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accessLogCursorBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *AccessLogCursor) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accessLogCursorAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAccessLogCursorHook registers your hook function for all future operations.
+func AddAccessLogCursorHook(hookPoint boil.HookPoint, accessLogCursorHook AccessLogCursorHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		accessLogCursorAfterSelectMu.Lock()
+		accessLogCursorAfterSelectHooks = append(accessLogCursorAfterSelectHooks, accessLogCursorHook)
+		accessLogCursorAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		accessLogCursorBeforeInsertMu.Lock()
+		accessLogCursorBeforeInsertHooks = append(accessLogCursorBeforeInsertHooks, accessLogCursorHook)
+		accessLogCursorBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		accessLogCursorAfterInsertMu.Lock()
+		accessLogCursorAfterInsertHooks = append(accessLogCursorAfterInsertHooks, accessLogCursorHook)
+		accessLogCursorAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		accessLogCursorBeforeUpdateMu.Lock()
+		accessLogCursorBeforeUpdateHooks = append(accessLogCursorBeforeUpdateHooks, accessLogCursorHook)
+		accessLogCursorBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		accessLogCursorAfterUpdateMu.Lock()
+		accessLogCursorAfterUpdateHooks = append(accessLogCursorAfterUpdateHooks, accessLogCursorHook)
+		accessLogCursorAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		accessLogCursorBeforeDeleteMu.Lock()
+		accessLogCursorBeforeDeleteHooks = append(accessLogCursorBeforeDeleteHooks, accessLogCursorHook)
+		accessLogCursorBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		accessLogCursorAfterDeleteMu.Lock()
+		accessLogCursorAfterDeleteHooks = append(accessLogCursorAfterDeleteHooks, accessLogCursorHook)
+		accessLogCursorAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		accessLogCursorBeforeUpsertMu.Lock()
+		accessLogCursorBeforeUpsertHooks = append(accessLogCursorBeforeUpsertHooks, accessLogCursorHook)
+		accessLogCursorBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		accessLogCursorAfterUpsertMu.Lock()
+		accessLogCursorAfterUpsertHooks = append(accessLogCursorAfterUpsertHooks, accessLogCursorHook)
+		accessLogCursorAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single accessLogCursor record from the query using the global executor.
+func (q accessLogCursorQuery) OneG(ctx context.Context) (*AccessLogCursor, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single accessLogCursor record from the query.
+func (q accessLogCursorQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AccessLogCursor, error) {
+	o := &AccessLogCursor{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for access_log_cursor")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all AccessLogCursor records from the query using the global executor.
+func (q accessLogCursorQuery) AllG(ctx context.Context) (AccessLogCursorSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all AccessLogCursor records from the query.
+func (q accessLogCursorQuery) All(ctx context.Context, exec boil.ContextExecutor) (AccessLogCursorSlice, error) {
+	var o []*AccessLogCursor
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to AccessLogCursor slice")
+	}
+
+	if len(accessLogCursorAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all AccessLogCursor records in the query using the global executor
+func (q accessLogCursorQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all AccessLogCursor records in the query.
+func (q accessLogCursorQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count access_log_cursor rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q accessLogCursorQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q accessLogCursorQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if access_log_cursor exists")
+	}
+
+	return count > 0, nil
+}
+
+// Configuration pointed to by the foreign key.
+func (o *AccessLogCursor) Configuration(mods ...qm.QueryMod) configurationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ConfigurationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Configurations(queryMods...)
+}
+
+// LoadConfiguration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (accessLogCursorL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccessLogCursor interface{}, mods queries.Applicator) error {
+	var slice []*AccessLogCursor
+	var object *AccessLogCursor
+
+	if singular {
+		var ok bool
+		object, ok = maybeAccessLogCursor.(*AccessLogCursor)
+		if !ok {
+			object = new(AccessLogCursor)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAccessLogCursor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAccessLogCursor))
+			}
+		}
+	} else {
+		s, ok := maybeAccessLogCursor.(*[]*AccessLogCursor)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAccessLogCursor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAccessLogCursor))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &accessLogCursorR{}
+		}
+		args[object.ConfigurationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accessLogCursorR{}
+			}
+
+			args[obj.ConfigurationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`kentix.configuration`),
+		qm.WhereIn(`kentix.configuration.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Configuration")
+	}
+
+	var resultSlice []*Configuration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Configuration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for configuration")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for configuration")
+	}
+
+	if len(configurationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Configuration = foreign
+		if foreign.R == nil {
+			foreign.R = &configurationR{}
+		}
+		foreign.R.AccessLogCursor = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ConfigurationID == foreign.ID {
+				local.R.Configuration = foreign
+				if foreign.R == nil {
+					foreign.R = &configurationR{}
+				}
+				foreign.R.AccessLogCursor = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetConfigurationG of the accessLogCursor to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.AccessLogCursor.
+// Uses the global database handle.
+func (o *AccessLogCursor) SetConfigurationG(ctx context.Context, insert bool, related *Configuration) error {
+	return o.SetConfiguration(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetConfiguration of the accessLogCursor to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.AccessLogCursor.
+func (o *AccessLogCursor) SetConfiguration(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Configuration) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"kentix\".\"access_log_cursor\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+		strmangle.WhereClause("\"", "\"", 2, accessLogCursorPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ConfigurationID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ConfigurationID = related.ID
+	if o.R == nil {
+		o.R = &accessLogCursorR{
+			Configuration: related,
+		}
+	} else {
+		o.R.Configuration = related
+	}
+
+	if related.R == nil {
+		related.R = &configurationR{
+			AccessLogCursor: o,
+		}
+	} else {
+		related.R.AccessLogCursor = o
+	}
+
+	return nil
+}
+
+// AccessLogCursors retrieves all the records using an executor.
+func AccessLogCursors(mods ...qm.QueryMod) accessLogCursorQuery {
+	mods = append(mods, qm.From("\"kentix\".\"access_log_cursor\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"kentix\".\"access_log_cursor\".*"})
+	}
+
+	return accessLogCursorQuery{q}
+}
+
+// FindAccessLogCursorG retrieves a single record by ID.
+func FindAccessLogCursorG(ctx context.Context, configurationID int64, selectCols ...string) (*AccessLogCursor, error) {
+	return FindAccessLogCursor(ctx, boil.GetContextDB(), configurationID, selectCols...)
+}
+
+// FindAccessLogCursor retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAccessLogCursor(ctx context.Context, exec boil.ContextExecutor, configurationID int64, selectCols ...string) (*AccessLogCursor, error) {
+	accessLogCursorObj := &AccessLogCursor{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"kentix\".\"access_log_cursor\" where \"configuration_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, configurationID)
+
+	err := q.Bind(ctx, exec, accessLogCursorObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from access_log_cursor")
+	}
+
+	if err = accessLogCursorObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return accessLogCursorObj, err
+	}
+
+	return accessLogCursorObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *AccessLogCursor) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AccessLogCursor) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no access_log_cursor provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(accessLogCursorColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	accessLogCursorInsertCacheMut.RLock()
+	cache, cached := accessLogCursorInsertCache[key]
+	accessLogCursorInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			accessLogCursorAllColumns,
+			accessLogCursorColumnsWithDefault,
+			accessLogCursorColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(accessLogCursorType, accessLogCursorMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(accessLogCursorType, accessLogCursorMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"kentix\".\"access_log_cursor\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"kentix\".\"access_log_cursor\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into access_log_cursor")
+	}
+
+	if !cached {
+		accessLogCursorInsertCacheMut.Lock()
+		accessLogCursorInsertCache[key] = cache
+		accessLogCursorInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single AccessLogCursor record using the global executor.
+// See Update for more documentation.
+func (o *AccessLogCursor) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the AccessLogCursor.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AccessLogCursor) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	accessLogCursorUpdateCacheMut.RLock()
+	cache, cached := accessLogCursorUpdateCache[key]
+	accessLogCursorUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			accessLogCursorAllColumns,
+			accessLogCursorPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update access_log_cursor, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"kentix\".\"access_log_cursor\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, accessLogCursorPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(accessLogCursorType, accessLogCursorMapping, append(wl, accessLogCursorPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update access_log_cursor row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for access_log_cursor")
+	}
+
+	if !cached {
+		accessLogCursorUpdateCacheMut.Lock()
+		accessLogCursorUpdateCache[key] = cache
+		accessLogCursorUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q accessLogCursorQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q accessLogCursorQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for access_log_cursor")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for access_log_cursor")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o AccessLogCursorSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AccessLogCursorSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), accessLogCursorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"kentix\".\"access_log_cursor\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, accessLogCursorPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in accessLogCursor slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all accessLogCursor")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *AccessLogCursor) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AccessLogCursor) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("appdb: no access_log_cursor provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(accessLogCursorColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	accessLogCursorUpsertCacheMut.RLock()
+	cache, cached := accessLogCursorUpsertCache[key]
+	accessLogCursorUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			accessLogCursorAllColumns,
+			accessLogCursorColumnsWithDefault,
+			accessLogCursorColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			accessLogCursorAllColumns,
+			accessLogCursorPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert access_log_cursor, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(accessLogCursorAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(accessLogCursorPrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert access_log_cursor, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(accessLogCursorPrimaryKeyColumns))
+			copy(conflict, accessLogCursorPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"kentix\".\"access_log_cursor\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(accessLogCursorType, accessLogCursorMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(accessLogCursorType, accessLogCursorMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert access_log_cursor")
+	}
+
+	if !cached {
+		accessLogCursorUpsertCacheMut.Lock()
+		accessLogCursorUpsertCache[key] = cache
+		accessLogCursorUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single AccessLogCursor record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *AccessLogCursor) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single AccessLogCursor record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AccessLogCursor) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no AccessLogCursor provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), accessLogCursorPrimaryKeyMapping)
+	sql := "DELETE FROM \"kentix\".\"access_log_cursor\" WHERE \"configuration_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from access_log_cursor")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for access_log_cursor")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q accessLogCursorQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q accessLogCursorQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no accessLogCursorQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from access_log_cursor")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for access_log_cursor")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o AccessLogCursorSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AccessLogCursorSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(accessLogCursorBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), accessLogCursorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"kentix\".\"access_log_cursor\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, accessLogCursorPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from accessLogCursor slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for access_log_cursor")
+	}
+
+	if len(accessLogCursorAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *AccessLogCursor) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no AccessLogCursor provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AccessLogCursor) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAccessLogCursor(ctx, exec, o.ConfigurationID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AccessLogCursorSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty AccessLogCursorSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AccessLogCursorSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AccessLogCursorSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), accessLogCursorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"kentix\".\"access_log_cursor\".* FROM \"kentix\".\"access_log_cursor\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, accessLogCursorPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in AccessLogCursorSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AccessLogCursorExistsG checks if the AccessLogCursor row exists.
+func AccessLogCursorExistsG(ctx context.Context, configurationID int64) (bool, error) {
+	return AccessLogCursorExists(ctx, boil.GetContextDB(), configurationID)
+}
+
+// AccessLogCursorExists checks if the AccessLogCursor row exists.
+func AccessLogCursorExists(ctx context.Context, exec boil.ContextExecutor, configurationID int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"kentix\".\"access_log_cursor\" where \"configuration_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, configurationID)
+	}
+	row := exec.QueryRowContext(ctx, sql, configurationID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if access_log_cursor exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the AccessLogCursor row exists.
+func (o *AccessLogCursor) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return AccessLogCursorExists(ctx, exec, o.ConfigurationID)
+}
@@ -1,12 +1,24 @@
-// Code generated by SQLBoiler 4.14.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
 // This file is meant to be re-generated in place and/or deleted at any time.
 
 package appdb
 
 var TableNames = struct {
-	Configuration string
-	Sensor        string
+	AccessLogCursor     string
+	Configuration       string
+	ConfigurationStatus string
+	PollLease           string
+	Sensor              string
+	SlaveCertificate    string
+	SlaveStatus         string
+	Webhook             string
 }{
-	Configuration: "configuration",
-	Sensor:        "sensor",
+	AccessLogCursor:     "access_log_cursor",
+	Configuration:       "configuration",
+	ConfigurationStatus: "configuration_status",
+	PollLease:           "poll_lease",
+	Sensor:              "sensor",
+	SlaveCertificate:    "slave_certificate",
+	SlaveStatus:         "slave_status",
+	Webhook:             "webhook",
 }
@@ -1,4 +1,4 @@
-// Code generated by SQLBoiler 4.14.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
 // This file is meant to be re-generated in place and/or deleted at any time.
 
 package appdb
@@ -25,84 +25,91 @@ import (
 
 // Configuration is an object representing the database table.
 type Configuration struct {
-	ID              int64             `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Address         null.String       `boil:"address" json:"address,omitempty" toml:"address" yaml:"address,omitempty"`
-	APIKey          null.String       `boil:"api_key" json:"api_key,omitempty" toml:"api_key" yaml:"api_key,omitempty"`
-	Enable          null.Bool         `boil:"enable" json:"enable,omitempty" toml:"enable" yaml:"enable,omitempty"`
-	RefreshInterval int32             `boil:"refresh_interval" json:"refresh_interval" toml:"refresh_interval" yaml:"refresh_interval"`
-	RequestTimeout  int32             `boil:"request_timeout" json:"request_timeout" toml:"request_timeout" yaml:"request_timeout"`
-	Active          null.Bool         `boil:"active" json:"active,omitempty" toml:"active" yaml:"active,omitempty"`
-	ProjectIds      types.StringArray `boil:"project_ids" json:"project_ids,omitempty" toml:"project_ids" yaml:"project_ids,omitempty"`
+	ID                     int64             `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Address                null.String       `boil:"address" json:"address,omitempty" toml:"address" yaml:"address,omitempty"`
+	APIKey                 null.String       `boil:"api_key" json:"api_key,omitempty" toml:"api_key" yaml:"api_key,omitempty"`
+	Enable                 null.Bool         `boil:"enable" json:"enable,omitempty" toml:"enable" yaml:"enable,omitempty"`
+	RefreshInterval        int32             `boil:"refresh_interval" json:"refresh_interval" toml:"refresh_interval" yaml:"refresh_interval"`
+	RequestTimeout         int32             `boil:"request_timeout" json:"request_timeout" toml:"request_timeout" yaml:"request_timeout"`
+	Active                 null.Bool         `boil:"active" json:"active,omitempty" toml:"active" yaml:"active,omitempty"`
+	ProjectIds             types.StringArray `boil:"project_ids" json:"project_ids,omitempty" toml:"project_ids" yaml:"project_ids,omitempty"`
+	WebhookSecret          null.String       `boil:"webhook_secret" json:"webhook_secret,omitempty" toml:"webhook_secret" yaml:"webhook_secret,omitempty"`
+	CaCertificate          null.String       `boil:"ca_certificate" json:"ca_certificate,omitempty" toml:"ca_certificate" yaml:"ca_certificate,omitempty"`
+	CertificateFingerprint null.String       `boil:"certificate_fingerprint" json:"certificate_fingerprint,omitempty" toml:"certificate_fingerprint" yaml:"certificate_fingerprint,omitempty"`
+	TrustOnFirstUse        null.Bool         `boil:"trust_on_first_use" json:"trust_on_first_use,omitempty" toml:"trust_on_first_use" yaml:"trust_on_first_use,omitempty"`
+	Insecure               null.Bool         `boil:"insecure" json:"insecure,omitempty" toml:"insecure" yaml:"insecure,omitempty"`
+	OfflineAlarmThreshold  int32             `boil:"offline_alarm_threshold" json:"offline_alarm_threshold" toml:"offline_alarm_threshold" yaml:"offline_alarm_threshold"`
 
 	R *configurationR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L configurationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ConfigurationColumns = struct {
-	ID              string
-	Address         string
-	APIKey          string
-	Enable          string
-	RefreshInterval string
-	RequestTimeout  string
-	Active          string
-	ProjectIds      string
+	ID                     string
+	Address                string
+	APIKey                 string
+	Enable                 string
+	RefreshInterval        string
+	RequestTimeout         string
+	Active                 string
+	ProjectIds             string
+	WebhookSecret          string
+	CaCertificate          string
+	CertificateFingerprint string
+	TrustOnFirstUse        string
+	Insecure               string
+	OfflineAlarmThreshold  string
 }{
-	ID:              "id",
-	Address:         "address",
-	APIKey:          "api_key",
-	Enable:          "enable",
-	RefreshInterval: "refresh_interval",
-	RequestTimeout:  "request_timeout",
-	Active:          "active",
-	ProjectIds:      "project_ids",
+	ID:                     "id",
+	Address:                "address",
+	APIKey:                 "api_key",
+	Enable:                 "enable",
+	RefreshInterval:        "refresh_interval",
+	RequestTimeout:         "request_timeout",
+	Active:                 "active",
+	ProjectIds:             "project_ids",
+	WebhookSecret:          "webhook_secret",
+	CaCertificate:          "ca_certificate",
+	CertificateFingerprint: "certificate_fingerprint",
+	TrustOnFirstUse:        "trust_on_first_use",
+	Insecure:               "insecure",
+	OfflineAlarmThreshold:  "offline_alarm_threshold",
 }
 
 var ConfigurationTableColumns = struct {
-	ID              string
-	Address         string
-	APIKey          string
-	Enable          string
-	RefreshInterval string
-	RequestTimeout  string
-	Active          string
-	ProjectIds      string
+	ID                     string
+	Address                string
+	APIKey                 string
+	Enable                 string
+	RefreshInterval        string
+	RequestTimeout         string
+	Active                 string
+	ProjectIds             string
+	WebhookSecret          string
+	CaCertificate          string
+	CertificateFingerprint string
+	TrustOnFirstUse        string
+	Insecure               string
+	OfflineAlarmThreshold  string
 }{
-	ID:              "configuration.id",
-	Address:         "configuration.address",
-	APIKey:          "configuration.api_key",
-	Enable:          "configuration.enable",
-	RefreshInterval: "configuration.refresh_interval",
-	RequestTimeout:  "configuration.request_timeout",
-	Active:          "configuration.active",
-	ProjectIds:      "configuration.project_ids",
+	ID:                     "configuration.id",
+	Address:                "configuration.address",
+	APIKey:                 "configuration.api_key",
+	Enable:                 "configuration.enable",
+	RefreshInterval:        "configuration.refresh_interval",
+	RequestTimeout:         "configuration.request_timeout",
+	Active:                 "configuration.active",
+	ProjectIds:             "configuration.project_ids",
+	WebhookSecret:          "configuration.webhook_secret",
+	CaCertificate:          "configuration.ca_certificate",
+	CertificateFingerprint: "configuration.certificate_fingerprint",
+	TrustOnFirstUse:        "configuration.trust_on_first_use",
+	Insecure:               "configuration.insecure",
+	OfflineAlarmThreshold:  "configuration.offline_alarm_threshold",
 }
 
 // Generated where
 
-type whereHelperint64 struct{ field string }
-
-func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 type whereHelpernull_String struct{ field string }
 
 func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
@@ -123,6 +130,18 @@ func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
 func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
+func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" LIKE ?", x)
+}
+func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT LIKE ?", x)
+}
+func (w whereHelpernull_String) ILIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" ILIKE ?", x)
+}
+func (w whereHelpernull_String) NILIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT ILIKE ?", x)
+}
 func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
 	values := make([]interface{}, 0, len(slice))
 	for _, value := range slice {
@@ -215,35 +234,65 @@ func (w whereHelpertypes_StringArray) IsNotNull() qm.QueryMod {
 }
 
 var ConfigurationWhere = struct {
-	ID              whereHelperint64
-	Address         whereHelpernull_String
-	APIKey          whereHelpernull_String
-	Enable          whereHelpernull_Bool
-	RefreshInterval whereHelperint32
-	RequestTimeout  whereHelperint32
-	Active          whereHelpernull_Bool
-	ProjectIds      whereHelpertypes_StringArray
+	ID                     whereHelperint64
+	Address                whereHelpernull_String
+	APIKey                 whereHelpernull_String
+	Enable                 whereHelpernull_Bool
+	RefreshInterval        whereHelperint32
+	RequestTimeout         whereHelperint32
+	Active                 whereHelpernull_Bool
+	ProjectIds             whereHelpertypes_StringArray
+	WebhookSecret          whereHelpernull_String
+	CaCertificate          whereHelpernull_String
+	CertificateFingerprint whereHelpernull_String
+	TrustOnFirstUse        whereHelpernull_Bool
+	Insecure               whereHelpernull_Bool
+	OfflineAlarmThreshold  whereHelperint32
 }{
-	ID:              whereHelperint64{field: "\"kentix\".\"configuration\".\"id\""},
-	Address:         whereHelpernull_String{field: "\"kentix\".\"configuration\".\"address\""},
-	APIKey:          whereHelpernull_String{field: "\"kentix\".\"configuration\".\"api_key\""},
-	Enable:          whereHelpernull_Bool{field: "\"kentix\".\"configuration\".\"enable\""},
-	RefreshInterval: whereHelperint32{field: "\"kentix\".\"configuration\".\"refresh_interval\""},
-	RequestTimeout:  whereHelperint32{field: "\"kentix\".\"configuration\".\"request_timeout\""},
-	Active:          whereHelpernull_Bool{field: "\"kentix\".\"configuration\".\"active\""},
-	ProjectIds:      whereHelpertypes_StringArray{field: "\"kentix\".\"configuration\".\"project_ids\""},
+	ID:                     whereHelperint64{field: "\"kentix\".\"configuration\".\"id\""},
+	Address:                whereHelpernull_String{field: "\"kentix\".\"configuration\".\"address\""},
+	APIKey:                 whereHelpernull_String{field: "\"kentix\".\"configuration\".\"api_key\""},
+	Enable:                 whereHelpernull_Bool{field: "\"kentix\".\"configuration\".\"enable\""},
+	RefreshInterval:        whereHelperint32{field: "\"kentix\".\"configuration\".\"refresh_interval\""},
+	RequestTimeout:         whereHelperint32{field: "\"kentix\".\"configuration\".\"request_timeout\""},
+	Active:                 whereHelpernull_Bool{field: "\"kentix\".\"configuration\".\"active\""},
+	ProjectIds:             whereHelpertypes_StringArray{field: "\"kentix\".\"configuration\".\"project_ids\""},
+	WebhookSecret:          whereHelpernull_String{field: "\"kentix\".\"configuration\".\"webhook_secret\""},
+	CaCertificate:          whereHelpernull_String{field: "\"kentix\".\"configuration\".\"ca_certificate\""},
+	CertificateFingerprint: whereHelpernull_String{field: "\"kentix\".\"configuration\".\"certificate_fingerprint\""},
+	TrustOnFirstUse:        whereHelpernull_Bool{field: "\"kentix\".\"configuration\".\"trust_on_first_use\""},
+	Insecure:               whereHelpernull_Bool{field: "\"kentix\".\"configuration\".\"insecure\""},
+	OfflineAlarmThreshold:  whereHelperint32{field: "\"kentix\".\"configuration\".\"offline_alarm_threshold\""},
 }
 
 // ConfigurationRels is where relationship names are stored.
 var ConfigurationRels = struct {
-	Sensors string
+	AccessLogCursor     string
+	ConfigurationStatus string
+	PollLease           string
+	Webhook             string
+	Sensors             string
+	SlaveCertificates   string
+	SlaveStatuses       string
 }{
-	Sensors: "Sensors",
+	AccessLogCursor:     "AccessLogCursor",
+	ConfigurationStatus: "ConfigurationStatus",
+	PollLease:           "PollLease",
+	Webhook:             "Webhook",
+	Sensors:             "Sensors",
+	SlaveCertificates:   "SlaveCertificates",
+	SlaveStatuses:       "SlaveStatuses",
 }
 
 // configurationR is where relationships are stored.
 type configurationR struct {
-	Sensors SensorSlice `boil:"Sensors" json:"Sensors" toml:"Sensors" yaml:"Sensors"`
+	AccessLogCursor     *AccessLogCursor      `boil:"AccessLogCursor" json:"AccessLogCursor" toml:"AccessLogCursor" yaml:"AccessLogCursor"`
+	ConfigurationStatus *ConfigurationStatus  `boil:"ConfigurationStatus" json:"ConfigurationStatus" toml:"ConfigurationStatus" yaml:"ConfigurationStatus"`
+	PollLease           *PollLease            `boil:"PollLease" json:"PollLease" toml:"PollLease" yaml:"PollLease"`
+	Webhook             *Webhook              `boil:"Webhook" json:"Webhook" toml:"Webhook" yaml:"Webhook"`
+	Sensors             SensorSlice           `boil:"Sensors" json:"Sensors" toml:"Sensors" yaml:"Sensors"`
+	SlaveCertificates   SlaveCertificateSlice `boil:"SlaveCertificates" json:"SlaveCertificates" toml:"SlaveCertificates" yaml:"SlaveCertificates"`
+	SlaveStatuses       SlaveStatusSlice      `boil:"SlaveStatuses" json:"SlaveStatuses" toml:"SlaveStatuses" yaml:"SlaveStatuses"`
 }
 
 // NewStruct creates a new relationship struct
@@ -251,6 +300,34 @@ func (*configurationR) NewStruct() *configurationR {
 	return &configurationR{}
 }
 
+func (r *configurationR) GetAccessLogCursor() *AccessLogCursor {
+	if r == nil {
+		return nil
+	}
+	return r.AccessLogCursor
+}
+
+func (r *configurationR) GetConfigurationStatus() *ConfigurationStatus {
+	if r == nil {
+		return nil
+	}
+	return r.ConfigurationStatus
+}
+
+func (r *configurationR) GetPollLease() *PollLease {
+	if r == nil {
+		return nil
+	}
+	return r.PollLease
+}
+
+func (r *configurationR) GetWebhook() *Webhook {
+	if r == nil {
+		return nil
+	}
+	return r.Webhook
+}
+
 func (r *configurationR) GetSensors() SensorSlice {
 	if r == nil {
 		return nil
@@ -258,13 +335,27 @@ func (r *configurationR) GetSensors() SensorSlice {
 	return r.Sensors
 }
 
+func (r *configurationR) GetSlaveCertificates() SlaveCertificateSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SlaveCertificates
+}
+
+func (r *configurationR) GetSlaveStatuses() SlaveStatusSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SlaveStatuses
+}
+
 // configurationL is where Load methods for each relationship are stored.
 type configurationL struct{}
 
 var (
-	configurationAllColumns            = []string{"id", "address", "api_key", "enable", "refresh_interval", "request_timeout", "active", "project_ids"}
+	configurationAllColumns            = []string{"id", "address", "api_key", "enable", "refresh_interval", "request_timeout", "active", "project_ids", "webhook_secret", "ca_certificate", "certificate_fingerprint", "trust_on_first_use", "insecure", "offline_alarm_threshold"}
 	configurationColumnsWithoutDefault = []string{}
-	configurationColumnsWithDefault    = []string{"id", "address", "api_key", "enable", "refresh_interval", "request_timeout", "active", "project_ids"}
+	configurationColumnsWithDefault    = []string{"id", "address", "api_key", "enable", "refresh_interval", "request_timeout", "active", "project_ids", "webhook_secret", "ca_certificate", "certificate_fingerprint", "trust_on_first_use", "insecure", "offline_alarm_threshold"}
 	configurationPrimaryKeyColumns     = []string{"id"}
 	configurationGeneratedColumns      = []string{}
 )
@@ -302,18 +393,27 @@ var (
 	_ = qmhelper.Where
 )
 
+var configurationAfterSelectMu sync.Mutex
 var configurationAfterSelectHooks []ConfigurationHook
 
+var configurationBeforeInsertMu sync.Mutex
 var configurationBeforeInsertHooks []ConfigurationHook
+var configurationAfterInsertMu sync.Mutex
 var configurationAfterInsertHooks []ConfigurationHook
 
+var configurationBeforeUpdateMu sync.Mutex
 var configurationBeforeUpdateHooks []ConfigurationHook
+var configurationAfterUpdateMu sync.Mutex
 var configurationAfterUpdateHooks []ConfigurationHook
 
+var configurationBeforeDeleteMu sync.Mutex
 var configurationBeforeDeleteHooks []ConfigurationHook
+var configurationAfterDeleteMu sync.Mutex
 var configurationAfterDeleteHooks []ConfigurationHook
 
+var configurationBeforeUpsertMu sync.Mutex
 var configurationBeforeUpsertHooks []ConfigurationHook
+var configurationAfterUpsertMu sync.Mutex
 var configurationAfterUpsertHooks []ConfigurationHook
 
 // doAfterSelectHooks executes all "after Select" hooks.
@@ -455,23 +555,41 @@ func (o *Configuration) doAfterUpsertHooks(ctx context.Context, exec boil.Contex
 func AddConfigurationHook(hookPoint boil.HookPoint, configurationHook ConfigurationHook) {
 	switch hookPoint {
 	case boil.AfterSelectHook:
+		configurationAfterSelectMu.Lock()
 		configurationAfterSelectHooks = append(configurationAfterSelectHooks, configurationHook)
+		configurationAfterSelectMu.Unlock()
 	case boil.BeforeInsertHook:
+		configurationBeforeInsertMu.Lock()
 		configurationBeforeInsertHooks = append(configurationBeforeInsertHooks, configurationHook)
+		configurationBeforeInsertMu.Unlock()
 	case boil.AfterInsertHook:
+		configurationAfterInsertMu.Lock()
 		configurationAfterInsertHooks = append(configurationAfterInsertHooks, configurationHook)
+		configurationAfterInsertMu.Unlock()
 	case boil.BeforeUpdateHook:
+		configurationBeforeUpdateMu.Lock()
 		configurationBeforeUpdateHooks = append(configurationBeforeUpdateHooks, configurationHook)
+		configurationBeforeUpdateMu.Unlock()
 	case boil.AfterUpdateHook:
+		configurationAfterUpdateMu.Lock()
 		configurationAfterUpdateHooks = append(configurationAfterUpdateHooks, configurationHook)
+		configurationAfterUpdateMu.Unlock()
 	case boil.BeforeDeleteHook:
+		configurationBeforeDeleteMu.Lock()
 		configurationBeforeDeleteHooks = append(configurationBeforeDeleteHooks, configurationHook)
+		configurationBeforeDeleteMu.Unlock()
 	case boil.AfterDeleteHook:
+		configurationAfterDeleteMu.Lock()
 		configurationAfterDeleteHooks = append(configurationAfterDeleteHooks, configurationHook)
+		configurationAfterDeleteMu.Unlock()
 	case boil.BeforeUpsertHook:
+		configurationBeforeUpsertMu.Lock()
 		configurationBeforeUpsertHooks = append(configurationBeforeUpsertHooks, configurationHook)
+		configurationBeforeUpsertMu.Unlock()
 	case boil.AfterUpsertHook:
+		configurationAfterUpsertMu.Lock()
 		configurationAfterUpsertHooks = append(configurationAfterUpsertHooks, configurationHook)
+		configurationAfterUpsertMu.Unlock()
 	}
 }
 
@@ -567,6 +685,50 @@ func (q configurationQuery) Exists(ctx context.Context, exec boil.ContextExecuto
 	return count > 0, nil
 }
 
+// AccessLogCursor pointed to by the foreign key.
+func (o *Configuration) AccessLogCursor(mods ...qm.QueryMod) accessLogCursorQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"configuration_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return AccessLogCursors(queryMods...)
+}
+
+// ConfigurationStatus pointed to by the foreign key.
+func (o *Configuration) ConfigurationStatus(mods ...qm.QueryMod) configurationStatusQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"configuration_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return ConfigurationStatuses(queryMods...)
+}
+
+// PollLease pointed to by the foreign key.
+func (o *Configuration) PollLease(mods ...qm.QueryMod) pollLeaseQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"configuration_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return PollLeases(queryMods...)
+}
+
+// Webhook pointed to by the foreign key.
+func (o *Configuration) Webhook(mods ...qm.QueryMod) webhookQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"configuration_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Webhooks(queryMods...)
+}
+
 // Sensors retrieves all the sensor's Sensors with an executor.
 func (o *Configuration) Sensors(mods ...qm.QueryMod) sensorQuery {
 	var queryMods []qm.QueryMod
@@ -581,9 +743,37 @@ func (o *Configuration) Sensors(mods ...qm.QueryMod) sensorQuery {
 	return Sensors(queryMods...)
 }
 
-// LoadSensors allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (configurationL) LoadSensors(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+// SlaveCertificates retrieves all the slave_certificate's SlaveCertificates with an executor.
+func (o *Configuration) SlaveCertificates(mods ...qm.QueryMod) slaveCertificateQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"kentix\".\"slave_certificate\".\"configuration_id\"=?", o.ID),
+	)
+
+	return SlaveCertificates(queryMods...)
+}
+
+// SlaveStatuses retrieves all the slave_status's SlaveStatuses with an executor.
+func (o *Configuration) SlaveStatuses(mods ...qm.QueryMod) slaveStatusQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"kentix\".\"slave_status\".\"configuration_id\"=?", o.ID),
+	)
+
+	return SlaveStatuses(queryMods...)
+}
+
+// LoadAccessLogCursor allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (configurationL) LoadAccessLogCursor(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
 	var slice []*Configuration
 	var object *Configuration
 
@@ -609,26 +799,19 @@ func (configurationL) LoadSensors(ctx context.Context, e boil.ContextExecutor, s
 		}
 	}
 
-	args := make([]interface{}, 0, 1)
+	args := make(map[interface{}]struct{})
 	if singular {
 		if object.R == nil {
 			object.R = &configurationR{}
 		}
-		args = append(args, object.ID)
+		args[object.ID] = struct{}{}
 	} else {
-	Outer:
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &configurationR{}
 			}
 
-			for _, a := range args {
-				if a == obj.ID {
-					continue Outer
-				}
-			}
-
-			args = append(args, obj.ID)
+			args[obj.ID] = struct{}{}
 		}
 	}
 
@@ -636,9 +819,16 @@ func (configurationL) LoadSensors(ctx context.Context, e boil.ContextExecutor, s
 		return nil
 	}
 
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
 	query := NewQuery(
-		qm.From(`kentix.sensor`),
-		qm.WhereIn(`kentix.sensor.configuration_id in ?`, args...),
+		qm.From(`kentix.access_log_cursor`),
+		qm.WhereIn(`kentix.access_log_cursor.configuration_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -646,45 +836,48 @@ func (configurationL) LoadSensors(ctx context.Context, e boil.ContextExecutor, s
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load sensor")
+		return errors.Wrap(err, "failed to eager load AccessLogCursor")
 	}
 
-	var resultSlice []*Sensor
+	var resultSlice []*AccessLogCursor
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice sensor")
+		return errors.Wrap(err, "failed to bind eager loaded slice AccessLogCursor")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on sensor")
+		return errors.Wrap(err, "failed to close results of eager load for access_log_cursor")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sensor")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for access_log_cursor")
 	}
 
-	if len(sensorAfterSelectHooks) != 0 {
+	if len(accessLogCursorAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.Sensors = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &sensorR{}
-			}
-			foreign.R.Configuration = object
+		foreign := resultSlice[0]
+		object.R.AccessLogCursor = foreign
+		if foreign.R == nil {
+			foreign.R = &accessLogCursorR{}
 		}
-		return nil
+		foreign.R.Configuration = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
 			if local.ID == foreign.ConfigurationID {
-				local.R.Sensors = append(local.R.Sensors, foreign)
+				local.R.AccessLogCursor = foreign
 				if foreign.R == nil {
-					foreign.R = &sensorR{}
+					foreign.R = &accessLogCursorR{}
 				}
 				foreign.R.Configuration = local
 				break
@@ -695,117 +888,1163 @@ func (configurationL) LoadSensors(ctx context.Context, e boil.ContextExecutor, s
 	return nil
 }
 
-// AddSensorsG adds the given related objects to the existing relationships
-// of the configuration, optionally inserting them as new records.
-// Appends related to o.R.Sensors.
-// Sets related.R.Configuration appropriately.
-// Uses the global database handle.
-func (o *Configuration) AddSensorsG(ctx context.Context, insert bool, related ...*Sensor) error {
-	return o.AddSensors(ctx, boil.GetContextDB(), insert, related...)
-}
+// LoadConfigurationStatus allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (configurationL) LoadConfigurationStatus(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
 
-// AddSensors adds the given related objects to the existing relationships
-// of the configuration, optionally inserting them as new records.
-// Appends related to o.R.Sensors.
-// Sets related.R.Configuration appropriately.
-func (o *Configuration) AddSensors(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Sensor) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.ConfigurationID = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
 			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
 		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"kentix\".\"sensor\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
-				strmangle.WhereClause("\"", "\"", 2, sensorPrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ConfigurationID, rel.ProjectID, rel.SerialNumber}
-
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
-			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
 			}
-
-			rel.ConfigurationID = o.ID
 		}
 	}
 
-	if o.R == nil {
-		o.R = &configurationR{
-			Sensors: related,
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
 		}
+		args[object.ID] = struct{}{}
 	} else {
-		o.R.Sensors = append(o.R.Sensors, related...)
-	}
-
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &sensorR{
-				Configuration: o,
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
 			}
-		} else {
-			rel.R.Configuration = o
+
+			args[obj.ID] = struct{}{}
 		}
 	}
-	return nil
-}
 
-// Configurations retrieves all the records using an executor.
-func Configurations(mods ...qm.QueryMod) configurationQuery {
-	mods = append(mods, qm.From("\"kentix\".\"configuration\""))
-	q := NewQuery(mods...)
-	if len(queries.GetSelect(q)) == 0 {
-		queries.SetSelect(q, []string{"\"kentix\".\"configuration\".*"})
+	if len(args) == 0 {
+		return nil
 	}
 
-	return configurationQuery{q}
-}
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
 
-// FindConfigurationG retrieves a single record by ID.
-func FindConfigurationG(ctx context.Context, iD int64, selectCols ...string) (*Configuration, error) {
-	return FindConfiguration(ctx, boil.GetContextDB(), iD, selectCols...)
-}
+	query := NewQuery(
+		qm.From(`kentix.configuration_status`),
+		qm.WhereIn(`kentix.configuration_status.configuration_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
 
-// FindConfiguration retrieves a single record by ID with an executor.
-// If selectCols is empty Find will return all columns.
-func FindConfiguration(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*Configuration, error) {
-	configurationObj := &Configuration{}
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ConfigurationStatus")
+	}
 
-	sel := "*"
-	if len(selectCols) > 0 {
-		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	var resultSlice []*ConfigurationStatus
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ConfigurationStatus")
 	}
-	query := fmt.Sprintf(
-		"select %s from \"kentix\".\"configuration\" where \"id\"=$1", sel,
-	)
 
-	q := queries.Raw(query, iD)
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for configuration_status")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for configuration_status")
+	}
 
-	err := q.Bind(ctx, exec, configurationObj)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, sql.ErrNoRows
+	if len(configurationStatusAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
 		}
-		return nil, errors.Wrap(err, "appdb: unable to select from configuration")
 	}
 
-	if err = configurationObj.doAfterSelectHooks(ctx, exec); err != nil {
-		return configurationObj, err
+	if len(resultSlice) == 0 {
+		return nil
 	}
 
-	return configurationObj, nil
-}
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ConfigurationStatus = foreign
+		if foreign.R == nil {
+			foreign.R = &configurationStatusR{}
+		}
+		foreign.R.Configuration = object
+	}
 
-// InsertG a single record. See Insert for whitelist behavior description.
-func (o *Configuration) InsertG(ctx context.Context, columns boil.Columns) error {
-	return o.Insert(ctx, boil.GetContextDB(), columns)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.ConfigurationStatus = foreign
+				if foreign.R == nil {
+					foreign.R = &configurationStatusR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPollLease allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (configurationL) LoadPollLease(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`kentix.poll_lease`),
+		qm.WhereIn(`kentix.poll_lease.configuration_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load PollLease")
+	}
+
+	var resultSlice []*PollLease
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice PollLease")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for poll_lease")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for poll_lease")
+	}
+
+	if len(pollLeaseAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.PollLease = foreign
+		if foreign.R == nil {
+			foreign.R = &pollLeaseR{}
+		}
+		foreign.R.Configuration = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.PollLease = foreign
+				if foreign.R == nil {
+					foreign.R = &pollLeaseR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebhook allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (configurationL) LoadWebhook(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`kentix.webhook`),
+		qm.WhereIn(`kentix.webhook.configuration_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Webhook")
+	}
+
+	var resultSlice []*Webhook
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Webhook")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for webhook")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webhook")
+	}
+
+	if len(webhookAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Webhook = foreign
+		if foreign.R == nil {
+			foreign.R = &webhookR{}
+		}
+		foreign.R.Configuration = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.Webhook = foreign
+				if foreign.R == nil {
+					foreign.R = &webhookR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSensors allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadSensors(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`kentix.sensor`),
+		qm.WhereIn(`kentix.sensor.configuration_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load sensor")
+	}
+
+	var resultSlice []*Sensor
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice sensor")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on sensor")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sensor")
+	}
+
+	if len(sensorAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Sensors = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &sensorR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.Sensors = append(local.R.Sensors, foreign)
+				if foreign.R == nil {
+					foreign.R = &sensorR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSlaveCertificates allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadSlaveCertificates(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`kentix.slave_certificate`),
+		qm.WhereIn(`kentix.slave_certificate.configuration_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load slave_certificate")
+	}
+
+	var resultSlice []*SlaveCertificate
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice slave_certificate")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on slave_certificate")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for slave_certificate")
+	}
+
+	if len(slaveCertificateAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SlaveCertificates = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &slaveCertificateR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.SlaveCertificates = append(local.R.SlaveCertificates, foreign)
+				if foreign.R == nil {
+					foreign.R = &slaveCertificateR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSlaveStatuses allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadSlaveStatuses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`kentix.slave_status`),
+		qm.WhereIn(`kentix.slave_status.configuration_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load slave_status")
+	}
+
+	var resultSlice []*SlaveStatus
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice slave_status")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on slave_status")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for slave_status")
+	}
+
+	if len(slaveStatusAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SlaveStatuses = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &slaveStatusR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.SlaveStatuses = append(local.R.SlaveStatuses, foreign)
+				if foreign.R == nil {
+					foreign.R = &slaveStatusR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAccessLogCursorG of the configuration to the related item.
+// Sets o.R.AccessLogCursor to related.
+// Adds o to related.R.Configuration.
+// Uses the global database handle.
+func (o *Configuration) SetAccessLogCursorG(ctx context.Context, insert bool, related *AccessLogCursor) error {
+	return o.SetAccessLogCursor(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetAccessLogCursor of the configuration to the related item.
+// Sets o.R.AccessLogCursor to related.
+// Adds o to related.R.Configuration.
+func (o *Configuration) SetAccessLogCursor(ctx context.Context, exec boil.ContextExecutor, insert bool, related *AccessLogCursor) error {
+	var err error
+
+	if insert {
+		related.ConfigurationID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"kentix\".\"access_log_cursor\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+			strmangle.WhereClause("\"", "\"", 2, accessLogCursorPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ConfigurationID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ConfigurationID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			AccessLogCursor: related,
+		}
+	} else {
+		o.R.AccessLogCursor = related
+	}
+
+	if related.R == nil {
+		related.R = &accessLogCursorR{
+			Configuration: o,
+		}
+	} else {
+		related.R.Configuration = o
+	}
+	return nil
+}
+
+// SetConfigurationStatusG of the configuration to the related item.
+// Sets o.R.ConfigurationStatus to related.
+// Adds o to related.R.Configuration.
+// Uses the global database handle.
+func (o *Configuration) SetConfigurationStatusG(ctx context.Context, insert bool, related *ConfigurationStatus) error {
+	return o.SetConfigurationStatus(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetConfigurationStatus of the configuration to the related item.
+// Sets o.R.ConfigurationStatus to related.
+// Adds o to related.R.Configuration.
+func (o *Configuration) SetConfigurationStatus(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ConfigurationStatus) error {
+	var err error
+
+	if insert {
+		related.ConfigurationID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"kentix\".\"configuration_status\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+			strmangle.WhereClause("\"", "\"", 2, configurationStatusPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ConfigurationID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ConfigurationID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			ConfigurationStatus: related,
+		}
+	} else {
+		o.R.ConfigurationStatus = related
+	}
+
+	if related.R == nil {
+		related.R = &configurationStatusR{
+			Configuration: o,
+		}
+	} else {
+		related.R.Configuration = o
+	}
+	return nil
+}
+
+// SetPollLeaseG of the configuration to the related item.
+// Sets o.R.PollLease to related.
+// Adds o to related.R.Configuration.
+// Uses the global database handle.
+func (o *Configuration) SetPollLeaseG(ctx context.Context, insert bool, related *PollLease) error {
+	return o.SetPollLease(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetPollLease of the configuration to the related item.
+// Sets o.R.PollLease to related.
+// Adds o to related.R.Configuration.
+func (o *Configuration) SetPollLease(ctx context.Context, exec boil.ContextExecutor, insert bool, related *PollLease) error {
+	var err error
+
+	if insert {
+		related.ConfigurationID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"kentix\".\"poll_lease\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+			strmangle.WhereClause("\"", "\"", 2, pollLeasePrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ConfigurationID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ConfigurationID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			PollLease: related,
+		}
+	} else {
+		o.R.PollLease = related
+	}
+
+	if related.R == nil {
+		related.R = &pollLeaseR{
+			Configuration: o,
+		}
+	} else {
+		related.R.Configuration = o
+	}
+	return nil
+}
+
+// SetWebhookG of the configuration to the related item.
+// Sets o.R.Webhook to related.
+// Adds o to related.R.Configuration.
+// Uses the global database handle.
+func (o *Configuration) SetWebhookG(ctx context.Context, insert bool, related *Webhook) error {
+	return o.SetWebhook(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetWebhook of the configuration to the related item.
+// Sets o.R.Webhook to related.
+// Adds o to related.R.Configuration.
+func (o *Configuration) SetWebhook(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Webhook) error {
+	var err error
+
+	if insert {
+		related.ConfigurationID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"kentix\".\"webhook\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+			strmangle.WhereClause("\"", "\"", 2, webhookPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ConfigurationID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ConfigurationID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			Webhook: related,
+		}
+	} else {
+		o.R.Webhook = related
+	}
+
+	if related.R == nil {
+		related.R = &webhookR{
+			Configuration: o,
+		}
+	} else {
+		related.R.Configuration = o
+	}
+	return nil
+}
+
+// AddSensorsG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.Sensors.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddSensorsG(ctx context.Context, insert bool, related ...*Sensor) error {
+	return o.AddSensors(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddSensors adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.Sensors.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddSensors(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Sensor) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"kentix\".\"sensor\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, sensorPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ConfigurationID, rel.ProjectID, rel.SerialNumber}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			Sensors: related,
+		}
+	} else {
+		o.R.Sensors = append(o.R.Sensors, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &sensorR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
+// AddSlaveCertificatesG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.SlaveCertificates.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddSlaveCertificatesG(ctx context.Context, insert bool, related ...*SlaveCertificate) error {
+	return o.AddSlaveCertificates(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddSlaveCertificates adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.SlaveCertificates.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddSlaveCertificates(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SlaveCertificate) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"kentix\".\"slave_certificate\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, slaveCertificatePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ConfigurationID, rel.SerialNumber}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			SlaveCertificates: related,
+		}
+	} else {
+		o.R.SlaveCertificates = append(o.R.SlaveCertificates, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &slaveCertificateR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
+// AddSlaveStatusesG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.SlaveStatuses.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddSlaveStatusesG(ctx context.Context, insert bool, related ...*SlaveStatus) error {
+	return o.AddSlaveStatuses(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddSlaveStatuses adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.SlaveStatuses.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddSlaveStatuses(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SlaveStatus) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"kentix\".\"slave_status\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, slaveStatusPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ConfigurationID, rel.SerialNumber}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			SlaveStatuses: related,
+		}
+	} else {
+		o.R.SlaveStatuses = append(o.R.SlaveStatuses, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &slaveStatusR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
+// Configurations retrieves all the records using an executor.
+func Configurations(mods ...qm.QueryMod) configurationQuery {
+	mods = append(mods, qm.From("\"kentix\".\"configuration\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"kentix\".\"configuration\".*"})
+	}
+
+	return configurationQuery{q}
+}
+
+// FindConfigurationG retrieves a single record by ID.
+func FindConfigurationG(ctx context.Context, iD int64, selectCols ...string) (*Configuration, error) {
+	return FindConfiguration(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindConfiguration retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindConfiguration(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*Configuration, error) {
+	configurationObj := &Configuration{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"kentix\".\"configuration\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, configurationObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from configuration")
+	}
+
+	if err = configurationObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return configurationObj, err
+	}
+
+	return configurationObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *Configuration) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
 }
 
 // Insert a single record using an executor.
@@ -1032,13 +2271,13 @@ func (o ConfigurationSlice) UpdateAll(ctx context.Context, exec boil.ContextExec
 }
 
 // UpsertG attempts an insert, and does an update or ignore on conflict.
-func (o *Configuration) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
-	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+func (o *Configuration) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
 }
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
 // See boil.Columns documentation for how to properly use updateColumns and insertColumns.
-func (o *Configuration) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+func (o *Configuration) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
 	if o == nil {
 		return errors.New("appdb: no configuration provided for upsert")
 	}
@@ -1084,7 +2323,7 @@ func (o *Configuration) Upsert(ctx context.Context, exec boil.ContextExecutor, u
 	var err error
 
 	if !cached {
-		insert, ret := insertColumns.InsertColumnSet(
+		insert, _ := insertColumns.InsertColumnSet(
 			configurationAllColumns,
 			configurationColumnsWithDefault,
 			configurationColumnsWithoutDefault,
@@ -1100,12 +2339,18 @@ func (o *Configuration) Upsert(ctx context.Context, exec boil.ContextExecutor, u
 			return errors.New("appdb: unable to upsert configuration, could not build update column list")
 		}
 
+		ret := strmangle.SetComplement(configurationAllColumns, strmangle.SetIntersect(insert, update))
+
 		conflict := conflictColumns
-		if len(conflict) == 0 {
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(configurationPrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert configuration, could not build conflict column list")
+			}
+
 			conflict = make([]string, len(configurationPrimaryKeyColumns))
 			copy(conflict, configurationPrimaryKeyColumns)
 		}
-		cache.query = buildUpsertQueryPostgres(dialect, "\"kentix\".\"configuration\"", updateOnConflict, ret, update, conflict, insert)
+		cache.query = buildUpsertQueryPostgres(dialect, "\"kentix\".\"configuration\"", updateOnConflict, ret, update, conflict, insert, opts...)
 
 		cache.valueMapping, err = queries.BindMapping(configurationType, configurationMapping, insert)
 		if err != nil {
@@ -0,0 +1,1289 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ConfigurationStatus is an object representing the database table.
+type ConfigurationStatus struct {
+	ConfigurationID     int64       `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	DeviceSerial        null.String `boil:"device_serial" json:"device_serial,omitempty" toml:"device_serial" yaml:"device_serial,omitempty"`
+	LastSuccessAt       null.Time   `boil:"last_success_at" json:"last_success_at,omitempty" toml:"last_success_at" yaml:"last_success_at,omitempty"`
+	LastError           null.String `boil:"last_error" json:"last_error,omitempty" toml:"last_error" yaml:"last_error,omitempty"`
+	LastErrorAt         null.Time   `boil:"last_error_at" json:"last_error_at,omitempty" toml:"last_error_at" yaml:"last_error_at,omitempty"`
+	ConsecutiveFailures int32       `boil:"consecutive_failures" json:"consecutive_failures" toml:"consecutive_failures" yaml:"consecutive_failures"`
+	PollDurationMS      int32       `boil:"poll_duration_ms" json:"poll_duration_ms" toml:"poll_duration_ms" yaml:"poll_duration_ms"`
+	AssetsUpdated       int32       `boil:"assets_updated" json:"assets_updated" toml:"assets_updated" yaml:"assets_updated"`
+	CircuitOpenUntil    null.Time   `boil:"circuit_open_until" json:"circuit_open_until,omitempty" toml:"circuit_open_until" yaml:"circuit_open_until,omitempty"`
+
+	R *configurationStatusR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L configurationStatusL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ConfigurationStatusColumns = struct {
+	ConfigurationID     string
+	DeviceSerial        string
+	LastSuccessAt       string
+	LastError           string
+	LastErrorAt         string
+	ConsecutiveFailures string
+	PollDurationMS      string
+	AssetsUpdated       string
+	CircuitOpenUntil    string
+}{
+	ConfigurationID:     "configuration_id",
+	DeviceSerial:        "device_serial",
+	LastSuccessAt:       "last_success_at",
+	LastError:           "last_error",
+	LastErrorAt:         "last_error_at",
+	ConsecutiveFailures: "consecutive_failures",
+	PollDurationMS:      "poll_duration_ms",
+	AssetsUpdated:       "assets_updated",
+	CircuitOpenUntil:    "circuit_open_until",
+}
+
+var ConfigurationStatusTableColumns = struct {
+	ConfigurationID     string
+	DeviceSerial        string
+	LastSuccessAt       string
+	LastError           string
+	LastErrorAt         string
+	ConsecutiveFailures string
+	PollDurationMS      string
+	AssetsUpdated       string
+	CircuitOpenUntil    string
+}{
+	ConfigurationID:     "configuration_status.configuration_id",
+	DeviceSerial:        "configuration_status.device_serial",
+	LastSuccessAt:       "configuration_status.last_success_at",
+	LastError:           "configuration_status.last_error",
+	LastErrorAt:         "configuration_status.last_error_at",
+	ConsecutiveFailures: "configuration_status.consecutive_failures",
+	PollDurationMS:      "configuration_status.poll_duration_ms",
+	AssetsUpdated:       "configuration_status.assets_updated",
+	CircuitOpenUntil:    "configuration_status.circuit_open_until",
+}
+
+// Generated where
+
+type whereHelpernull_Time struct{ field string }
+
+func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var ConfigurationStatusWhere = struct {
+	ConfigurationID     whereHelperint64
+	DeviceSerial        whereHelpernull_String
+	LastSuccessAt       whereHelpernull_Time
+	LastError           whereHelpernull_String
+	LastErrorAt         whereHelpernull_Time
+	ConsecutiveFailures whereHelperint32
+	PollDurationMS      whereHelperint32
+	AssetsUpdated       whereHelperint32
+	CircuitOpenUntil    whereHelpernull_Time
+}{
+	ConfigurationID:     whereHelperint64{field: "\"kentix\".\"configuration_status\".\"configuration_id\""},
+	DeviceSerial:        whereHelpernull_String{field: "\"kentix\".\"configuration_status\".\"device_serial\""},
+	LastSuccessAt:       whereHelpernull_Time{field: "\"kentix\".\"configuration_status\".\"last_success_at\""},
+	LastError:           whereHelpernull_String{field: "\"kentix\".\"configuration_status\".\"last_error\""},
+	LastErrorAt:         whereHelpernull_Time{field: "\"kentix\".\"configuration_status\".\"last_error_at\""},
+	ConsecutiveFailures: whereHelperint32{field: "\"kentix\".\"configuration_status\".\"consecutive_failures\""},
+	PollDurationMS:      whereHelperint32{field: "\"kentix\".\"configuration_status\".\"poll_duration_ms\""},
+	AssetsUpdated:       whereHelperint32{field: "\"kentix\".\"configuration_status\".\"assets_updated\""},
+	CircuitOpenUntil:    whereHelpernull_Time{field: "\"kentix\".\"configuration_status\".\"circuit_open_until\""},
+}
+
+// ConfigurationStatusRels is where relationship names are stored.
+var ConfigurationStatusRels = struct {
+	Configuration string
+}{
+	Configuration: "Configuration",
+}
+
+// configurationStatusR is where relationships are stored.
+type configurationStatusR struct {
+	Configuration *Configuration `boil:"Configuration" json:"Configuration" toml:"Configuration" yaml:"Configuration"`
+}
+
+// NewStruct creates a new relationship struct
+func (*configurationStatusR) NewStruct() *configurationStatusR {
+	return &configurationStatusR{}
+}
+
+func (r *configurationStatusR) GetConfiguration() *Configuration {
+	if r == nil {
+		return nil
+	}
+	return r.Configuration
+}
+
+// configurationStatusL is where Load methods for each relationship are stored.
+type configurationStatusL struct{}
+
+var (
+	configurationStatusAllColumns            = []string{"configuration_id", "device_serial", "last_success_at", "last_error", "last_error_at", "consecutive_failures", "poll_duration_ms", "assets_updated", "circuit_open_until"}
+	configurationStatusColumnsWithoutDefault = []string{"configuration_id"}
+	configurationStatusColumnsWithDefault    = []string{"device_serial", "last_success_at", "last_error", "last_error_at", "consecutive_failures", "poll_duration_ms", "assets_updated", "circuit_open_until"}
+	configurationStatusPrimaryKeyColumns     = []string{"configuration_id"}
+	configurationStatusGeneratedColumns      = []string{}
+)
+
+type (
+	// ConfigurationStatusSlice is an alias for a slice of pointers to ConfigurationStatus.
+	// This should almost always be used instead of []ConfigurationStatus.
+	ConfigurationStatusSlice []*ConfigurationStatus
+	// ConfigurationStatusHook is the signature for custom ConfigurationStatus hook methods
+	ConfigurationStatusHook func(context.Context, boil.ContextExecutor, *ConfigurationStatus) error
+
+	configurationStatusQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	configurationStatusType                 = reflect.TypeOf(&ConfigurationStatus{})
+	configurationStatusMapping              = queries.MakeStructMapping(configurationStatusType)
+	configurationStatusPrimaryKeyMapping, _ = queries.BindMapping(configurationStatusType, configurationStatusMapping, configurationStatusPrimaryKeyColumns)
+	configurationStatusInsertCacheMut       sync.RWMutex
+	configurationStatusInsertCache          = make(map[string]insertCache)
+	configurationStatusUpdateCacheMut       sync.RWMutex
+	configurationStatusUpdateCache          = make(map[string]updateCache)
+	configurationStatusUpsertCacheMut       sync.RWMutex
+	configurationStatusUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var configurationStatusAfterSelectMu sync.Mutex
+var configurationStatusAfterSelectHooks []ConfigurationStatusHook
+
+var configurationStatusBeforeInsertMu sync.Mutex
+var configurationStatusBeforeInsertHooks []ConfigurationStatusHook
+var configurationStatusAfterInsertMu sync.Mutex
+var configurationStatusAfterInsertHooks []ConfigurationStatusHook
+
+var configurationStatusBeforeUpdateMu sync.Mutex
+var configurationStatusBeforeUpdateHooks []ConfigurationStatusHook
+var configurationStatusAfterUpdateMu sync.Mutex
+var configurationStatusAfterUpdateHooks []ConfigurationStatusHook
+
+var configurationStatusBeforeDeleteMu sync.Mutex
+var configurationStatusBeforeDeleteHooks []ConfigurationStatusHook
+var configurationStatusAfterDeleteMu sync.Mutex
+var configurationStatusAfterDeleteHooks []ConfigurationStatusHook
+
+var configurationStatusBeforeUpsertMu sync.Mutex
+var configurationStatusBeforeUpsertHooks []ConfigurationStatusHook
+var configurationStatusAfterUpsertMu sync.Mutex
+var configurationStatusAfterUpsertHooks []ConfigurationStatusHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ConfigurationStatus) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range configurationStatusAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ConfigurationStatus) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range configurationStatusBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ConfigurationStatus) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range configurationStatusAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ConfigurationStatus) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range configurationStatusBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ConfigurationStatus) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range configurationStatusAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ConfigurationStatus) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range configurationStatusBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ConfigurationStatus) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range configurationStatusAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ConfigurationStatus) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range configurationStatusBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ConfigurationStatus) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range configurationStatusAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddConfigurationStatusHook registers your hook function for all future operations.
+func AddConfigurationStatusHook(hookPoint boil.HookPoint, configurationStatusHook ConfigurationStatusHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		configurationStatusAfterSelectMu.Lock()
+		configurationStatusAfterSelectHooks = append(configurationStatusAfterSelectHooks, configurationStatusHook)
+		configurationStatusAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		configurationStatusBeforeInsertMu.Lock()
+		configurationStatusBeforeInsertHooks = append(configurationStatusBeforeInsertHooks, configurationStatusHook)
+		configurationStatusBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		configurationStatusAfterInsertMu.Lock()
+		configurationStatusAfterInsertHooks = append(configurationStatusAfterInsertHooks, configurationStatusHook)
+		configurationStatusAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		configurationStatusBeforeUpdateMu.Lock()
+		configurationStatusBeforeUpdateHooks = append(configurationStatusBeforeUpdateHooks, configurationStatusHook)
+		configurationStatusBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		configurationStatusAfterUpdateMu.Lock()
+		configurationStatusAfterUpdateHooks = append(configurationStatusAfterUpdateHooks, configurationStatusHook)
+		configurationStatusAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		configurationStatusBeforeDeleteMu.Lock()
+		configurationStatusBeforeDeleteHooks = append(configurationStatusBeforeDeleteHooks, configurationStatusHook)
+		configurationStatusBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		configurationStatusAfterDeleteMu.Lock()
+		configurationStatusAfterDeleteHooks = append(configurationStatusAfterDeleteHooks, configurationStatusHook)
+		configurationStatusAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		configurationStatusBeforeUpsertMu.Lock()
+		configurationStatusBeforeUpsertHooks = append(configurationStatusBeforeUpsertHooks, configurationStatusHook)
+		configurationStatusBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		configurationStatusAfterUpsertMu.Lock()
+		configurationStatusAfterUpsertHooks = append(configurationStatusAfterUpsertHooks, configurationStatusHook)
+		configurationStatusAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single configurationStatus record from the query using the global executor.
+func (q configurationStatusQuery) OneG(ctx context.Context) (*ConfigurationStatus, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single configurationStatus record from the query.
+func (q configurationStatusQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ConfigurationStatus, error) {
+	o := &ConfigurationStatus{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for configuration_status")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all ConfigurationStatus records from the query using the global executor.
+func (q configurationStatusQuery) AllG(ctx context.Context) (ConfigurationStatusSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all ConfigurationStatus records from the query.
+func (q configurationStatusQuery) All(ctx context.Context, exec boil.ContextExecutor) (ConfigurationStatusSlice, error) {
+	var o []*ConfigurationStatus
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to ConfigurationStatus slice")
+	}
+
+	if len(configurationStatusAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ConfigurationStatus records in the query using the global executor
+func (q configurationStatusQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all ConfigurationStatus records in the query.
+func (q configurationStatusQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count configuration_status rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q configurationStatusQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q configurationStatusQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if configuration_status exists")
+	}
+
+	return count > 0, nil
+}
+
+// Configuration pointed to by the foreign key.
+func (o *ConfigurationStatus) Configuration(mods ...qm.QueryMod) configurationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ConfigurationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Configurations(queryMods...)
+}
+
+// LoadConfiguration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (configurationStatusL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfigurationStatus interface{}, mods queries.Applicator) error {
+	var slice []*ConfigurationStatus
+	var object *ConfigurationStatus
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfigurationStatus.(*ConfigurationStatus)
+		if !ok {
+			object = new(ConfigurationStatus)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfigurationStatus)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfigurationStatus))
+			}
+		}
+	} else {
+		s, ok := maybeConfigurationStatus.(*[]*ConfigurationStatus)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfigurationStatus)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfigurationStatus))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &configurationStatusR{}
+		}
+		args[object.ConfigurationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationStatusR{}
+			}
+
+			args[obj.ConfigurationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`kentix.configuration`),
+		qm.WhereIn(`kentix.configuration.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Configuration")
+	}
+
+	var resultSlice []*Configuration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Configuration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for configuration")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for configuration")
+	}
+
+	if len(configurationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Configuration = foreign
+		if foreign.R == nil {
+			foreign.R = &configurationR{}
+		}
+		foreign.R.ConfigurationStatus = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ConfigurationID == foreign.ID {
+				local.R.Configuration = foreign
+				if foreign.R == nil {
+					foreign.R = &configurationR{}
+				}
+				foreign.R.ConfigurationStatus = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetConfigurationG of the configurationStatus to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.ConfigurationStatus.
+// Uses the global database handle.
+func (o *ConfigurationStatus) SetConfigurationG(ctx context.Context, insert bool, related *Configuration) error {
+	return o.SetConfiguration(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetConfiguration of the configurationStatus to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.ConfigurationStatus.
+func (o *ConfigurationStatus) SetConfiguration(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Configuration) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"kentix\".\"configuration_status\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+		strmangle.WhereClause("\"", "\"", 2, configurationStatusPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ConfigurationID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ConfigurationID = related.ID
+	if o.R == nil {
+		o.R = &configurationStatusR{
+			Configuration: related,
+		}
+	} else {
+		o.R.Configuration = related
+	}
+
+	if related.R == nil {
+		related.R = &configurationR{
+			ConfigurationStatus: o,
+		}
+	} else {
+		related.R.ConfigurationStatus = o
+	}
+
+	return nil
+}
+
+// ConfigurationStatuses retrieves all the records using an executor.
+func ConfigurationStatuses(mods ...qm.QueryMod) configurationStatusQuery {
+	mods = append(mods, qm.From("\"kentix\".\"configuration_status\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"kentix\".\"configuration_status\".*"})
+	}
+
+	return configurationStatusQuery{q}
+}
+
+// FindConfigurationStatusG retrieves a single record by ID.
+func FindConfigurationStatusG(ctx context.Context, configurationID int64, selectCols ...string) (*ConfigurationStatus, error) {
+	return FindConfigurationStatus(ctx, boil.GetContextDB(), configurationID, selectCols...)
+}
+
+// FindConfigurationStatus retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindConfigurationStatus(ctx context.Context, exec boil.ContextExecutor, configurationID int64, selectCols ...string) (*ConfigurationStatus, error) {
+	configurationStatusObj := &ConfigurationStatus{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"kentix\".\"configuration_status\" where \"configuration_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, configurationID)
+
+	err := q.Bind(ctx, exec, configurationStatusObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from configuration_status")
+	}
+
+	if err = configurationStatusObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return configurationStatusObj, err
+	}
+
+	return configurationStatusObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ConfigurationStatus) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ConfigurationStatus) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no configuration_status provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(configurationStatusColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	configurationStatusInsertCacheMut.RLock()
+	cache, cached := configurationStatusInsertCache[key]
+	configurationStatusInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			configurationStatusAllColumns,
+			configurationStatusColumnsWithDefault,
+			configurationStatusColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(configurationStatusType, configurationStatusMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(configurationStatusType, configurationStatusMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"kentix\".\"configuration_status\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"kentix\".\"configuration_status\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into configuration_status")
+	}
+
+	if !cached {
+		configurationStatusInsertCacheMut.Lock()
+		configurationStatusInsertCache[key] = cache
+		configurationStatusInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single ConfigurationStatus record using the global executor.
+// See Update for more documentation.
+func (o *ConfigurationStatus) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the ConfigurationStatus.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ConfigurationStatus) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	configurationStatusUpdateCacheMut.RLock()
+	cache, cached := configurationStatusUpdateCache[key]
+	configurationStatusUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			configurationStatusAllColumns,
+			configurationStatusPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update configuration_status, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"kentix\".\"configuration_status\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, configurationStatusPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(configurationStatusType, configurationStatusMapping, append(wl, configurationStatusPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update configuration_status row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for configuration_status")
+	}
+
+	if !cached {
+		configurationStatusUpdateCacheMut.Lock()
+		configurationStatusUpdateCache[key] = cache
+		configurationStatusUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q configurationStatusQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q configurationStatusQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for configuration_status")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for configuration_status")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ConfigurationStatusSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ConfigurationStatusSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), configurationStatusPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"kentix\".\"configuration_status\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, configurationStatusPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in configurationStatus slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all configurationStatus")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ConfigurationStatus) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ConfigurationStatus) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("appdb: no configuration_status provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(configurationStatusColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	configurationStatusUpsertCacheMut.RLock()
+	cache, cached := configurationStatusUpsertCache[key]
+	configurationStatusUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			configurationStatusAllColumns,
+			configurationStatusColumnsWithDefault,
+			configurationStatusColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			configurationStatusAllColumns,
+			configurationStatusPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert configuration_status, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(configurationStatusAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(configurationStatusPrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert configuration_status, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(configurationStatusPrimaryKeyColumns))
+			copy(conflict, configurationStatusPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"kentix\".\"configuration_status\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(configurationStatusType, configurationStatusMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(configurationStatusType, configurationStatusMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert configuration_status")
+	}
+
+	if !cached {
+		configurationStatusUpsertCacheMut.Lock()
+		configurationStatusUpsertCache[key] = cache
+		configurationStatusUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single ConfigurationStatus record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ConfigurationStatus) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single ConfigurationStatus record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ConfigurationStatus) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no ConfigurationStatus provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), configurationStatusPrimaryKeyMapping)
+	sql := "DELETE FROM \"kentix\".\"configuration_status\" WHERE \"configuration_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from configuration_status")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for configuration_status")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q configurationStatusQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q configurationStatusQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no configurationStatusQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from configuration_status")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for configuration_status")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ConfigurationStatusSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ConfigurationStatusSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(configurationStatusBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), configurationStatusPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"kentix\".\"configuration_status\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, configurationStatusPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from configurationStatus slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for configuration_status")
+	}
+
+	if len(configurationStatusAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ConfigurationStatus) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no ConfigurationStatus provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ConfigurationStatus) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindConfigurationStatus(ctx, exec, o.ConfigurationID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ConfigurationStatusSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty ConfigurationStatusSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ConfigurationStatusSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ConfigurationStatusSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), configurationStatusPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"kentix\".\"configuration_status\".* FROM \"kentix\".\"configuration_status\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, configurationStatusPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in ConfigurationStatusSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ConfigurationStatusExistsG checks if the ConfigurationStatus row exists.
+func ConfigurationStatusExistsG(ctx context.Context, configurationID int64) (bool, error) {
+	return ConfigurationStatusExists(ctx, boil.GetContextDB(), configurationID)
+}
+
+// ConfigurationStatusExists checks if the ConfigurationStatus row exists.
+func ConfigurationStatusExists(ctx context.Context, exec boil.ContextExecutor, configurationID int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"kentix\".\"configuration_status\" where \"configuration_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, configurationID)
+	}
+	row := exec.QueryRowContext(ctx, sql, configurationID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if configuration_status exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ConfigurationStatus row exists.
+func (o *ConfigurationStatus) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ConfigurationStatusExists(ctx, exec, o.ConfigurationID)
+}
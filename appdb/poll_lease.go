@@ -0,0 +1,1270 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// PollLease is an object representing the database table.
+type PollLease struct {
+	ConfigurationID int64     `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	Owner           string    `boil:"owner" json:"owner" toml:"owner" yaml:"owner"`
+	ExpiresAt       time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+
+	R *pollLeaseR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L pollLeaseL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PollLeaseColumns = struct {
+	ConfigurationID string
+	Owner           string
+	ExpiresAt       string
+}{
+	ConfigurationID: "configuration_id",
+	Owner:           "owner",
+	ExpiresAt:       "expires_at",
+}
+
+var PollLeaseTableColumns = struct {
+	ConfigurationID string
+	Owner           string
+	ExpiresAt       string
+}{
+	ConfigurationID: "poll_lease.configuration_id",
+	Owner:           "poll_lease.owner",
+	ExpiresAt:       "poll_lease.expires_at",
+}
+
+// Generated where
+
+type whereHelperstring struct{ field string }
+
+func (w whereHelperstring) EQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperstring) NEQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperstring) LT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperstring) LTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperstring) GT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperstring) GTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperstring) LIKE(x string) qm.QueryMod   { return qm.Where(w.field+" LIKE ?", x) }
+func (w whereHelperstring) NLIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT LIKE ?", x) }
+func (w whereHelperstring) ILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" ILIKE ?", x) }
+func (w whereHelperstring) NILIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT ILIKE ?", x) }
+func (w whereHelperstring) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var PollLeaseWhere = struct {
+	ConfigurationID whereHelperint64
+	Owner           whereHelperstring
+	ExpiresAt       whereHelpertime_Time
+}{
+	ConfigurationID: whereHelperint64{field: "\"kentix\".\"poll_lease\".\"configuration_id\""},
+	Owner:           whereHelperstring{field: "\"kentix\".\"poll_lease\".\"owner\""},
+	ExpiresAt:       whereHelpertime_Time{field: "\"kentix\".\"poll_lease\".\"expires_at\""},
+}
+
+// PollLeaseRels is where relationship names are stored.
+var PollLeaseRels = struct {
+	Configuration string
+}{
+	Configuration: "Configuration",
+}
+
+// pollLeaseR is where relationships are stored.
+type pollLeaseR struct {
+	Configuration *Configuration `boil:"Configuration" json:"Configuration" toml:"Configuration" yaml:"Configuration"`
+}
+
+// NewStruct creates a new relationship struct
+func (*pollLeaseR) NewStruct() *pollLeaseR {
+	return &pollLeaseR{}
+}
+
+func (r *pollLeaseR) GetConfiguration() *Configuration {
+	if r == nil {
+		return nil
+	}
+	return r.Configuration
+}
+
+// pollLeaseL is where Load methods for each relationship are stored.
+type pollLeaseL struct{}
+
+var (
+	pollLeaseAllColumns            = []string{"configuration_id", "owner", "expires_at"}
+	pollLeaseColumnsWithoutDefault = []string{"configuration_id", "owner", "expires_at"}
+	pollLeaseColumnsWithDefault    = []string{}
+	pollLeasePrimaryKeyColumns     = []string{"configuration_id"}
+	pollLeaseGeneratedColumns      = []string{}
+)
+
+type (
+	// PollLeaseSlice is an alias for a slice of pointers to PollLease.
+	// This should almost always be used instead of []PollLease.
+	PollLeaseSlice []*PollLease
+	// PollLeaseHook is the signature for custom PollLease hook methods
+	PollLeaseHook func(context.Context, boil.ContextExecutor, *PollLease) error
+
+	pollLeaseQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	pollLeaseType                 = reflect.TypeOf(&PollLease{})
+	pollLeaseMapping              = queries.MakeStructMapping(pollLeaseType)
+	pollLeasePrimaryKeyMapping, _ = queries.BindMapping(pollLeaseType, pollLeaseMapping, pollLeasePrimaryKeyColumns)
+	pollLeaseInsertCacheMut       sync.RWMutex
+	pollLeaseInsertCache          = make(map[string]insertCache)
+	pollLeaseUpdateCacheMut       sync.RWMutex
+	pollLeaseUpdateCache          = make(map[string]updateCache)
+	pollLeaseUpsertCacheMut       sync.RWMutex
+	pollLeaseUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var pollLeaseAfterSelectMu sync.Mutex
+var pollLeaseAfterSelectHooks []PollLeaseHook
+
+var pollLeaseBeforeInsertMu sync.Mutex
+var pollLeaseBeforeInsertHooks []PollLeaseHook
+var pollLeaseAfterInsertMu sync.Mutex
+var pollLeaseAfterInsertHooks []PollLeaseHook
+
+var pollLeaseBeforeUpdateMu sync.Mutex
+var pollLeaseBeforeUpdateHooks []PollLeaseHook
+var pollLeaseAfterUpdateMu sync.Mutex
+var pollLeaseAfterUpdateHooks []PollLeaseHook
+
+var pollLeaseBeforeDeleteMu sync.Mutex
+var pollLeaseBeforeDeleteHooks []PollLeaseHook
+var pollLeaseAfterDeleteMu sync.Mutex
+var pollLeaseAfterDeleteHooks []PollLeaseHook
+
+var pollLeaseBeforeUpsertMu sync.Mutex
+var pollLeaseBeforeUpsertHooks []PollLeaseHook
+var pollLeaseAfterUpsertMu sync.Mutex
+var pollLeaseAfterUpsertHooks []PollLeaseHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PollLease) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollLeaseAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PollLease) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollLeaseBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PollLease) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollLeaseAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PollLease) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollLeaseBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PollLease) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollLeaseAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PollLease) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollLeaseBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PollLease) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollLeaseAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PollLease) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollLeaseBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PollLease) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pollLeaseAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPollLeaseHook registers your hook function for all future operations.
+func AddPollLeaseHook(hookPoint boil.HookPoint, pollLeaseHook PollLeaseHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		pollLeaseAfterSelectMu.Lock()
+		pollLeaseAfterSelectHooks = append(pollLeaseAfterSelectHooks, pollLeaseHook)
+		pollLeaseAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		pollLeaseBeforeInsertMu.Lock()
+		pollLeaseBeforeInsertHooks = append(pollLeaseBeforeInsertHooks, pollLeaseHook)
+		pollLeaseBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		pollLeaseAfterInsertMu.Lock()
+		pollLeaseAfterInsertHooks = append(pollLeaseAfterInsertHooks, pollLeaseHook)
+		pollLeaseAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		pollLeaseBeforeUpdateMu.Lock()
+		pollLeaseBeforeUpdateHooks = append(pollLeaseBeforeUpdateHooks, pollLeaseHook)
+		pollLeaseBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		pollLeaseAfterUpdateMu.Lock()
+		pollLeaseAfterUpdateHooks = append(pollLeaseAfterUpdateHooks, pollLeaseHook)
+		pollLeaseAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		pollLeaseBeforeDeleteMu.Lock()
+		pollLeaseBeforeDeleteHooks = append(pollLeaseBeforeDeleteHooks, pollLeaseHook)
+		pollLeaseBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		pollLeaseAfterDeleteMu.Lock()
+		pollLeaseAfterDeleteHooks = append(pollLeaseAfterDeleteHooks, pollLeaseHook)
+		pollLeaseAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		pollLeaseBeforeUpsertMu.Lock()
+		pollLeaseBeforeUpsertHooks = append(pollLeaseBeforeUpsertHooks, pollLeaseHook)
+		pollLeaseBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		pollLeaseAfterUpsertMu.Lock()
+		pollLeaseAfterUpsertHooks = append(pollLeaseAfterUpsertHooks, pollLeaseHook)
+		pollLeaseAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single pollLease record from the query using the global executor.
+func (q pollLeaseQuery) OneG(ctx context.Context) (*PollLease, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single pollLease record from the query.
+func (q pollLeaseQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PollLease, error) {
+	o := &PollLease{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for poll_lease")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all PollLease records from the query using the global executor.
+func (q pollLeaseQuery) AllG(ctx context.Context) (PollLeaseSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all PollLease records from the query.
+func (q pollLeaseQuery) All(ctx context.Context, exec boil.ContextExecutor) (PollLeaseSlice, error) {
+	var o []*PollLease
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to PollLease slice")
+	}
+
+	if len(pollLeaseAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all PollLease records in the query using the global executor
+func (q pollLeaseQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all PollLease records in the query.
+func (q pollLeaseQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count poll_lease rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q pollLeaseQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q pollLeaseQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if poll_lease exists")
+	}
+
+	return count > 0, nil
+}
+
+// Configuration pointed to by the foreign key.
+func (o *PollLease) Configuration(mods ...qm.QueryMod) configurationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ConfigurationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Configurations(queryMods...)
+}
+
+// LoadConfiguration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (pollLeaseL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, singular bool, maybePollLease interface{}, mods queries.Applicator) error {
+	var slice []*PollLease
+	var object *PollLease
+
+	if singular {
+		var ok bool
+		object, ok = maybePollLease.(*PollLease)
+		if !ok {
+			object = new(PollLease)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePollLease)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePollLease))
+			}
+		}
+	} else {
+		s, ok := maybePollLease.(*[]*PollLease)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePollLease)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePollLease))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pollLeaseR{}
+		}
+		args[object.ConfigurationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pollLeaseR{}
+			}
+
+			args[obj.ConfigurationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`kentix.configuration`),
+		qm.WhereIn(`kentix.configuration.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Configuration")
+	}
+
+	var resultSlice []*Configuration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Configuration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for configuration")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for configuration")
+	}
+
+	if len(configurationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Configuration = foreign
+		if foreign.R == nil {
+			foreign.R = &configurationR{}
+		}
+		foreign.R.PollLease = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ConfigurationID == foreign.ID {
+				local.R.Configuration = foreign
+				if foreign.R == nil {
+					foreign.R = &configurationR{}
+				}
+				foreign.R.PollLease = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetConfigurationG of the pollLease to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.PollLease.
+// Uses the global database handle.
+func (o *PollLease) SetConfigurationG(ctx context.Context, insert bool, related *Configuration) error {
+	return o.SetConfiguration(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetConfiguration of the pollLease to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.PollLease.
+func (o *PollLease) SetConfiguration(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Configuration) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"kentix\".\"poll_lease\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+		strmangle.WhereClause("\"", "\"", 2, pollLeasePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ConfigurationID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ConfigurationID = related.ID
+	if o.R == nil {
+		o.R = &pollLeaseR{
+			Configuration: related,
+		}
+	} else {
+		o.R.Configuration = related
+	}
+
+	if related.R == nil {
+		related.R = &configurationR{
+			PollLease: o,
+		}
+	} else {
+		related.R.PollLease = o
+	}
+
+	return nil
+}
+
+// PollLeases retrieves all the records using an executor.
+func PollLeases(mods ...qm.QueryMod) pollLeaseQuery {
+	mods = append(mods, qm.From("\"kentix\".\"poll_lease\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"kentix\".\"poll_lease\".*"})
+	}
+
+	return pollLeaseQuery{q}
+}
+
+// FindPollLeaseG retrieves a single record by ID.
+func FindPollLeaseG(ctx context.Context, configurationID int64, selectCols ...string) (*PollLease, error) {
+	return FindPollLease(ctx, boil.GetContextDB(), configurationID, selectCols...)
+}
+
+// FindPollLease retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPollLease(ctx context.Context, exec boil.ContextExecutor, configurationID int64, selectCols ...string) (*PollLease, error) {
+	pollLeaseObj := &PollLease{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"kentix\".\"poll_lease\" where \"configuration_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, configurationID)
+
+	err := q.Bind(ctx, exec, pollLeaseObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from poll_lease")
+	}
+
+	if err = pollLeaseObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return pollLeaseObj, err
+	}
+
+	return pollLeaseObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *PollLease) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PollLease) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no poll_lease provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pollLeaseColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	pollLeaseInsertCacheMut.RLock()
+	cache, cached := pollLeaseInsertCache[key]
+	pollLeaseInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			pollLeaseAllColumns,
+			pollLeaseColumnsWithDefault,
+			pollLeaseColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(pollLeaseType, pollLeaseMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(pollLeaseType, pollLeaseMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"kentix\".\"poll_lease\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"kentix\".\"poll_lease\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into poll_lease")
+	}
+
+	if !cached {
+		pollLeaseInsertCacheMut.Lock()
+		pollLeaseInsertCache[key] = cache
+		pollLeaseInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single PollLease record using the global executor.
+// See Update for more documentation.
+func (o *PollLease) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the PollLease.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PollLease) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	pollLeaseUpdateCacheMut.RLock()
+	cache, cached := pollLeaseUpdateCache[key]
+	pollLeaseUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			pollLeaseAllColumns,
+			pollLeasePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update poll_lease, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"kentix\".\"poll_lease\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, pollLeasePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(pollLeaseType, pollLeaseMapping, append(wl, pollLeasePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update poll_lease row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for poll_lease")
+	}
+
+	if !cached {
+		pollLeaseUpdateCacheMut.Lock()
+		pollLeaseUpdateCache[key] = cache
+		pollLeaseUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q pollLeaseQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q pollLeaseQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for poll_lease")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for poll_lease")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o PollLeaseSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PollLeaseSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pollLeasePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"kentix\".\"poll_lease\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, pollLeasePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in pollLease slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all pollLease")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *PollLease) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PollLease) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("appdb: no poll_lease provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pollLeaseColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	pollLeaseUpsertCacheMut.RLock()
+	cache, cached := pollLeaseUpsertCache[key]
+	pollLeaseUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			pollLeaseAllColumns,
+			pollLeaseColumnsWithDefault,
+			pollLeaseColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			pollLeaseAllColumns,
+			pollLeasePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert poll_lease, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(pollLeaseAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(pollLeasePrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert poll_lease, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(pollLeasePrimaryKeyColumns))
+			copy(conflict, pollLeasePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"kentix\".\"poll_lease\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(pollLeaseType, pollLeaseMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(pollLeaseType, pollLeaseMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert poll_lease")
+	}
+
+	if !cached {
+		pollLeaseUpsertCacheMut.Lock()
+		pollLeaseUpsertCache[key] = cache
+		pollLeaseUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single PollLease record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *PollLease) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single PollLease record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PollLease) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no PollLease provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), pollLeasePrimaryKeyMapping)
+	sql := "DELETE FROM \"kentix\".\"poll_lease\" WHERE \"configuration_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from poll_lease")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for poll_lease")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q pollLeaseQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q pollLeaseQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no pollLeaseQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from poll_lease")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for poll_lease")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o PollLeaseSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PollLeaseSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(pollLeaseBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pollLeasePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"kentix\".\"poll_lease\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, pollLeasePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from pollLease slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for poll_lease")
+	}
+
+	if len(pollLeaseAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *PollLease) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no PollLease provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PollLease) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPollLease(ctx, exec, o.ConfigurationID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PollLeaseSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty PollLeaseSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PollLeaseSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PollLeaseSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pollLeasePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"kentix\".\"poll_lease\".* FROM \"kentix\".\"poll_lease\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, pollLeasePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in PollLeaseSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PollLeaseExistsG checks if the PollLease row exists.
+func PollLeaseExistsG(ctx context.Context, configurationID int64) (bool, error) {
+	return PollLeaseExists(ctx, boil.GetContextDB(), configurationID)
+}
+
+// PollLeaseExists checks if the PollLease row exists.
+func PollLeaseExists(ctx context.Context, exec boil.ContextExecutor, configurationID int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"kentix\".\"poll_lease\" where \"configuration_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, configurationID)
+	}
+	row := exec.QueryRowContext(ctx, sql, configurationID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if poll_lease exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the PollLease row exists.
+func (o *PollLease) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return PollLeaseExists(ctx, exec, o.ConfigurationID)
+}
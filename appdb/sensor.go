@@ -1,4 +1,4 @@
-// Code generated by SQLBoiler 4.14.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
 // This file is meant to be re-generated in place and/or deleted at any time.
 
 package appdb
@@ -59,29 +59,6 @@ var SensorTableColumns = struct {
 
 // Generated where
 
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 type whereHelpernull_Int32 struct{ field string }
 
 func (w whereHelpernull_Int32) EQ(x null.Int32) qm.QueryMod {
@@ -200,18 +177,27 @@ var (
 	_ = qmhelper.Where
 )
 
+var sensorAfterSelectMu sync.Mutex
 var sensorAfterSelectHooks []SensorHook
 
+var sensorBeforeInsertMu sync.Mutex
 var sensorBeforeInsertHooks []SensorHook
+var sensorAfterInsertMu sync.Mutex
 var sensorAfterInsertHooks []SensorHook
 
+var sensorBeforeUpdateMu sync.Mutex
 var sensorBeforeUpdateHooks []SensorHook
+var sensorAfterUpdateMu sync.Mutex
 var sensorAfterUpdateHooks []SensorHook
 
+var sensorBeforeDeleteMu sync.Mutex
 var sensorBeforeDeleteHooks []SensorHook
+var sensorAfterDeleteMu sync.Mutex
 var sensorAfterDeleteHooks []SensorHook
 
+var sensorBeforeUpsertMu sync.Mutex
 var sensorBeforeUpsertHooks []SensorHook
+var sensorAfterUpsertMu sync.Mutex
 var sensorAfterUpsertHooks []SensorHook
 
 // doAfterSelectHooks executes all "after Select" hooks.
@@ -353,23 +339,41 @@ func (o *Sensor) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecut
 func AddSensorHook(hookPoint boil.HookPoint, sensorHook SensorHook) {
 	switch hookPoint {
 	case boil.AfterSelectHook:
+		sensorAfterSelectMu.Lock()
 		sensorAfterSelectHooks = append(sensorAfterSelectHooks, sensorHook)
+		sensorAfterSelectMu.Unlock()
 	case boil.BeforeInsertHook:
+		sensorBeforeInsertMu.Lock()
 		sensorBeforeInsertHooks = append(sensorBeforeInsertHooks, sensorHook)
+		sensorBeforeInsertMu.Unlock()
 	case boil.AfterInsertHook:
+		sensorAfterInsertMu.Lock()
 		sensorAfterInsertHooks = append(sensorAfterInsertHooks, sensorHook)
+		sensorAfterInsertMu.Unlock()
 	case boil.BeforeUpdateHook:
+		sensorBeforeUpdateMu.Lock()
 		sensorBeforeUpdateHooks = append(sensorBeforeUpdateHooks, sensorHook)
+		sensorBeforeUpdateMu.Unlock()
 	case boil.AfterUpdateHook:
+		sensorAfterUpdateMu.Lock()
 		sensorAfterUpdateHooks = append(sensorAfterUpdateHooks, sensorHook)
+		sensorAfterUpdateMu.Unlock()
 	case boil.BeforeDeleteHook:
+		sensorBeforeDeleteMu.Lock()
 		sensorBeforeDeleteHooks = append(sensorBeforeDeleteHooks, sensorHook)
+		sensorBeforeDeleteMu.Unlock()
 	case boil.AfterDeleteHook:
+		sensorAfterDeleteMu.Lock()
 		sensorAfterDeleteHooks = append(sensorAfterDeleteHooks, sensorHook)
+		sensorAfterDeleteMu.Unlock()
 	case boil.BeforeUpsertHook:
+		sensorBeforeUpsertMu.Lock()
 		sensorBeforeUpsertHooks = append(sensorBeforeUpsertHooks, sensorHook)
+		sensorBeforeUpsertMu.Unlock()
 	case boil.AfterUpsertHook:
+		sensorAfterUpsertMu.Lock()
 		sensorAfterUpsertHooks = append(sensorAfterUpsertHooks, sensorHook)
+		sensorAfterUpsertMu.Unlock()
 	}
 }
 
@@ -504,27 +508,20 @@ func (sensorL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, si
 		}
 	}
 
-	args := make([]interface{}, 0, 1)
+	args := make(map[interface{}]struct{})
 	if singular {
 		if object.R == nil {
 			object.R = &sensorR{}
 		}
-		args = append(args, object.ConfigurationID)
+		args[object.ConfigurationID] = struct{}{}
 
 	} else {
-	Outer:
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &sensorR{}
 			}
 
-			for _, a := range args {
-				if a == obj.ConfigurationID {
-					continue Outer
-				}
-			}
-
-			args = append(args, obj.ConfigurationID)
+			args[obj.ConfigurationID] = struct{}{}
 
 		}
 	}
@@ -533,9 +530,16 @@ func (sensorL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, si
 		return nil
 	}
 
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
 	query := NewQuery(
 		qm.From(`kentix.configuration`),
-		qm.WhereIn(`kentix.configuration.id in ?`, args...),
+		qm.WhereIn(`kentix.configuration.id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -926,13 +930,13 @@ func (o SensorSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, c
 }
 
 // UpsertG attempts an insert, and does an update or ignore on conflict.
-func (o *Sensor) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
-	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+func (o *Sensor) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
 }
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
 // See boil.Columns documentation for how to properly use updateColumns and insertColumns.
-func (o *Sensor) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+func (o *Sensor) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
 	if o == nil {
 		return errors.New("appdb: no sensor provided for upsert")
 	}
@@ -978,7 +982,7 @@ func (o *Sensor) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOn
 	var err error
 
 	if !cached {
-		insert, ret := insertColumns.InsertColumnSet(
+		insert, _ := insertColumns.InsertColumnSet(
 			sensorAllColumns,
 			sensorColumnsWithDefault,
 			sensorColumnsWithoutDefault,
@@ -994,12 +998,18 @@ func (o *Sensor) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOn
 			return errors.New("appdb: unable to upsert sensor, could not build update column list")
 		}
 
+		ret := strmangle.SetComplement(sensorAllColumns, strmangle.SetIntersect(insert, update))
+
 		conflict := conflictColumns
-		if len(conflict) == 0 {
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(sensorPrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert sensor, could not build conflict column list")
+			}
+
 			conflict = make([]string, len(sensorPrimaryKeyColumns))
 			copy(conflict, sensorPrimaryKeyColumns)
 		}
-		cache.query = buildUpsertQueryPostgres(dialect, "\"kentix\".\"sensor\"", updateOnConflict, ret, update, conflict, insert)
+		cache.query = buildUpsertQueryPostgres(dialect, "\"kentix\".\"sensor\"", updateOnConflict, ret, update, conflict, insert, opts...)
 
 		cache.valueMapping, err = queries.BindMapping(sensorType, sensorMapping, insert)
 		if err != nil {
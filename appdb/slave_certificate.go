@@ -0,0 +1,1222 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// SlaveCertificate is an object representing the database table.
+type SlaveCertificate struct {
+	ConfigurationID int64  `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	SerialNumber    string `boil:"serial_number" json:"serial_number" toml:"serial_number" yaml:"serial_number"`
+	Fingerprint     string `boil:"fingerprint" json:"fingerprint" toml:"fingerprint" yaml:"fingerprint"`
+
+	R *slaveCertificateR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L slaveCertificateL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SlaveCertificateColumns = struct {
+	ConfigurationID string
+	SerialNumber    string
+	Fingerprint     string
+}{
+	ConfigurationID: "configuration_id",
+	SerialNumber:    "serial_number",
+	Fingerprint:     "fingerprint",
+}
+
+var SlaveCertificateTableColumns = struct {
+	ConfigurationID string
+	SerialNumber    string
+	Fingerprint     string
+}{
+	ConfigurationID: "slave_certificate.configuration_id",
+	SerialNumber:    "slave_certificate.serial_number",
+	Fingerprint:     "slave_certificate.fingerprint",
+}
+
+// Generated where
+
+var SlaveCertificateWhere = struct {
+	ConfigurationID whereHelperint64
+	SerialNumber    whereHelperstring
+	Fingerprint     whereHelperstring
+}{
+	ConfigurationID: whereHelperint64{field: "\"kentix\".\"slave_certificate\".\"configuration_id\""},
+	SerialNumber:    whereHelperstring{field: "\"kentix\".\"slave_certificate\".\"serial_number\""},
+	Fingerprint:     whereHelperstring{field: "\"kentix\".\"slave_certificate\".\"fingerprint\""},
+}
+
+// SlaveCertificateRels is where relationship names are stored.
+var SlaveCertificateRels = struct {
+	Configuration string
+}{
+	Configuration: "Configuration",
+}
+
+// slaveCertificateR is where relationships are stored.
+type slaveCertificateR struct {
+	Configuration *Configuration `boil:"Configuration" json:"Configuration" toml:"Configuration" yaml:"Configuration"`
+}
+
+// NewStruct creates a new relationship struct
+func (*slaveCertificateR) NewStruct() *slaveCertificateR {
+	return &slaveCertificateR{}
+}
+
+func (r *slaveCertificateR) GetConfiguration() *Configuration {
+	if r == nil {
+		return nil
+	}
+	return r.Configuration
+}
+
+// slaveCertificateL is where Load methods for each relationship are stored.
+type slaveCertificateL struct{}
+
+var (
+	slaveCertificateAllColumns            = []string{"configuration_id", "serial_number", "fingerprint"}
+	slaveCertificateColumnsWithoutDefault = []string{"configuration_id", "serial_number", "fingerprint"}
+	slaveCertificateColumnsWithDefault    = []string{}
+	slaveCertificatePrimaryKeyColumns     = []string{"configuration_id", "serial_number"}
+	slaveCertificateGeneratedColumns      = []string{}
+)
+
+type (
+	// SlaveCertificateSlice is an alias for a slice of pointers to SlaveCertificate.
+	// This should almost always be used instead of []SlaveCertificate.
+	SlaveCertificateSlice []*SlaveCertificate
+	// SlaveCertificateHook is the signature for custom SlaveCertificate hook methods
+	SlaveCertificateHook func(context.Context, boil.ContextExecutor, *SlaveCertificate) error
+
+	slaveCertificateQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	slaveCertificateType                 = reflect.TypeOf(&SlaveCertificate{})
+	slaveCertificateMapping              = queries.MakeStructMapping(slaveCertificateType)
+	slaveCertificatePrimaryKeyMapping, _ = queries.BindMapping(slaveCertificateType, slaveCertificateMapping, slaveCertificatePrimaryKeyColumns)
+	slaveCertificateInsertCacheMut       sync.RWMutex
+	slaveCertificateInsertCache          = make(map[string]insertCache)
+	slaveCertificateUpdateCacheMut       sync.RWMutex
+	slaveCertificateUpdateCache          = make(map[string]updateCache)
+	slaveCertificateUpsertCacheMut       sync.RWMutex
+	slaveCertificateUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var slaveCertificateAfterSelectMu sync.Mutex
+var slaveCertificateAfterSelectHooks []SlaveCertificateHook
+
+var slaveCertificateBeforeInsertMu sync.Mutex
+var slaveCertificateBeforeInsertHooks []SlaveCertificateHook
+var slaveCertificateAfterInsertMu sync.Mutex
+var slaveCertificateAfterInsertHooks []SlaveCertificateHook
+
+var slaveCertificateBeforeUpdateMu sync.Mutex
+var slaveCertificateBeforeUpdateHooks []SlaveCertificateHook
+var slaveCertificateAfterUpdateMu sync.Mutex
+var slaveCertificateAfterUpdateHooks []SlaveCertificateHook
+
+var slaveCertificateBeforeDeleteMu sync.Mutex
+var slaveCertificateBeforeDeleteHooks []SlaveCertificateHook
+var slaveCertificateAfterDeleteMu sync.Mutex
+var slaveCertificateAfterDeleteHooks []SlaveCertificateHook
+
+var slaveCertificateBeforeUpsertMu sync.Mutex
+var slaveCertificateBeforeUpsertHooks []SlaveCertificateHook
+var slaveCertificateAfterUpsertMu sync.Mutex
+var slaveCertificateAfterUpsertHooks []SlaveCertificateHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *SlaveCertificate) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range slaveCertificateAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *SlaveCertificate) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range slaveCertificateBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *SlaveCertificate) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range slaveCertificateAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *SlaveCertificate) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range slaveCertificateBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *SlaveCertificate) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range slaveCertificateAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *SlaveCertificate) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range slaveCertificateBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *SlaveCertificate) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range slaveCertificateAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *SlaveCertificate) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range slaveCertificateBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *SlaveCertificate) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range slaveCertificateAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSlaveCertificateHook registers your hook function for all future operations.
+func AddSlaveCertificateHook(hookPoint boil.HookPoint, slaveCertificateHook SlaveCertificateHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		slaveCertificateAfterSelectMu.Lock()
+		slaveCertificateAfterSelectHooks = append(slaveCertificateAfterSelectHooks, slaveCertificateHook)
+		slaveCertificateAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		slaveCertificateBeforeInsertMu.Lock()
+		slaveCertificateBeforeInsertHooks = append(slaveCertificateBeforeInsertHooks, slaveCertificateHook)
+		slaveCertificateBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		slaveCertificateAfterInsertMu.Lock()
+		slaveCertificateAfterInsertHooks = append(slaveCertificateAfterInsertHooks, slaveCertificateHook)
+		slaveCertificateAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		slaveCertificateBeforeUpdateMu.Lock()
+		slaveCertificateBeforeUpdateHooks = append(slaveCertificateBeforeUpdateHooks, slaveCertificateHook)
+		slaveCertificateBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		slaveCertificateAfterUpdateMu.Lock()
+		slaveCertificateAfterUpdateHooks = append(slaveCertificateAfterUpdateHooks, slaveCertificateHook)
+		slaveCertificateAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		slaveCertificateBeforeDeleteMu.Lock()
+		slaveCertificateBeforeDeleteHooks = append(slaveCertificateBeforeDeleteHooks, slaveCertificateHook)
+		slaveCertificateBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		slaveCertificateAfterDeleteMu.Lock()
+		slaveCertificateAfterDeleteHooks = append(slaveCertificateAfterDeleteHooks, slaveCertificateHook)
+		slaveCertificateAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		slaveCertificateBeforeUpsertMu.Lock()
+		slaveCertificateBeforeUpsertHooks = append(slaveCertificateBeforeUpsertHooks, slaveCertificateHook)
+		slaveCertificateBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		slaveCertificateAfterUpsertMu.Lock()
+		slaveCertificateAfterUpsertHooks = append(slaveCertificateAfterUpsertHooks, slaveCertificateHook)
+		slaveCertificateAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single slaveCertificate record from the query using the global executor.
+func (q slaveCertificateQuery) OneG(ctx context.Context) (*SlaveCertificate, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single slaveCertificate record from the query.
+func (q slaveCertificateQuery) One(ctx context.Context, exec boil.ContextExecutor) (*SlaveCertificate, error) {
+	o := &SlaveCertificate{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for slave_certificate")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all SlaveCertificate records from the query using the global executor.
+func (q slaveCertificateQuery) AllG(ctx context.Context) (SlaveCertificateSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all SlaveCertificate records from the query.
+func (q slaveCertificateQuery) All(ctx context.Context, exec boil.ContextExecutor) (SlaveCertificateSlice, error) {
+	var o []*SlaveCertificate
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to SlaveCertificate slice")
+	}
+
+	if len(slaveCertificateAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all SlaveCertificate records in the query using the global executor
+func (q slaveCertificateQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all SlaveCertificate records in the query.
+func (q slaveCertificateQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count slave_certificate rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q slaveCertificateQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q slaveCertificateQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if slave_certificate exists")
+	}
+
+	return count > 0, nil
+}
+
+// Configuration pointed to by the foreign key.
+func (o *SlaveCertificate) Configuration(mods ...qm.QueryMod) configurationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ConfigurationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Configurations(queryMods...)
+}
+
+// LoadConfiguration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (slaveCertificateL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSlaveCertificate interface{}, mods queries.Applicator) error {
+	var slice []*SlaveCertificate
+	var object *SlaveCertificate
+
+	if singular {
+		var ok bool
+		object, ok = maybeSlaveCertificate.(*SlaveCertificate)
+		if !ok {
+			object = new(SlaveCertificate)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSlaveCertificate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSlaveCertificate))
+			}
+		}
+	} else {
+		s, ok := maybeSlaveCertificate.(*[]*SlaveCertificate)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSlaveCertificate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSlaveCertificate))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &slaveCertificateR{}
+		}
+		args[object.ConfigurationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &slaveCertificateR{}
+			}
+
+			args[obj.ConfigurationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`kentix.configuration`),
+		qm.WhereIn(`kentix.configuration.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Configuration")
+	}
+
+	var resultSlice []*Configuration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Configuration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for configuration")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for configuration")
+	}
+
+	if len(configurationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Configuration = foreign
+		if foreign.R == nil {
+			foreign.R = &configurationR{}
+		}
+		foreign.R.SlaveCertificates = append(foreign.R.SlaveCertificates, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ConfigurationID == foreign.ID {
+				local.R.Configuration = foreign
+				if foreign.R == nil {
+					foreign.R = &configurationR{}
+				}
+				foreign.R.SlaveCertificates = append(foreign.R.SlaveCertificates, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetConfigurationG of the slaveCertificate to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.SlaveCertificates.
+// Uses the global database handle.
+func (o *SlaveCertificate) SetConfigurationG(ctx context.Context, insert bool, related *Configuration) error {
+	return o.SetConfiguration(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetConfiguration of the slaveCertificate to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.SlaveCertificates.
+func (o *SlaveCertificate) SetConfiguration(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Configuration) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"kentix\".\"slave_certificate\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+		strmangle.WhereClause("\"", "\"", 2, slaveCertificatePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ConfigurationID, o.SerialNumber}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ConfigurationID = related.ID
+	if o.R == nil {
+		o.R = &slaveCertificateR{
+			Configuration: related,
+		}
+	} else {
+		o.R.Configuration = related
+	}
+
+	if related.R == nil {
+		related.R = &configurationR{
+			SlaveCertificates: SlaveCertificateSlice{o},
+		}
+	} else {
+		related.R.SlaveCertificates = append(related.R.SlaveCertificates, o)
+	}
+
+	return nil
+}
+
+// SlaveCertificates retrieves all the records using an executor.
+func SlaveCertificates(mods ...qm.QueryMod) slaveCertificateQuery {
+	mods = append(mods, qm.From("\"kentix\".\"slave_certificate\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"kentix\".\"slave_certificate\".*"})
+	}
+
+	return slaveCertificateQuery{q}
+}
+
+// FindSlaveCertificateG retrieves a single record by ID.
+func FindSlaveCertificateG(ctx context.Context, configurationID int64, serialNumber string, selectCols ...string) (*SlaveCertificate, error) {
+	return FindSlaveCertificate(ctx, boil.GetContextDB(), configurationID, serialNumber, selectCols...)
+}
+
+// FindSlaveCertificate retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSlaveCertificate(ctx context.Context, exec boil.ContextExecutor, configurationID int64, serialNumber string, selectCols ...string) (*SlaveCertificate, error) {
+	slaveCertificateObj := &SlaveCertificate{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"kentix\".\"slave_certificate\" where \"configuration_id\"=$1 AND \"serial_number\"=$2", sel,
+	)
+
+	q := queries.Raw(query, configurationID, serialNumber)
+
+	err := q.Bind(ctx, exec, slaveCertificateObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from slave_certificate")
+	}
+
+	if err = slaveCertificateObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return slaveCertificateObj, err
+	}
+
+	return slaveCertificateObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *SlaveCertificate) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SlaveCertificate) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no slave_certificate provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(slaveCertificateColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	slaveCertificateInsertCacheMut.RLock()
+	cache, cached := slaveCertificateInsertCache[key]
+	slaveCertificateInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			slaveCertificateAllColumns,
+			slaveCertificateColumnsWithDefault,
+			slaveCertificateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(slaveCertificateType, slaveCertificateMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(slaveCertificateType, slaveCertificateMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"kentix\".\"slave_certificate\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"kentix\".\"slave_certificate\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into slave_certificate")
+	}
+
+	if !cached {
+		slaveCertificateInsertCacheMut.Lock()
+		slaveCertificateInsertCache[key] = cache
+		slaveCertificateInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single SlaveCertificate record using the global executor.
+// See Update for more documentation.
+func (o *SlaveCertificate) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the SlaveCertificate.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SlaveCertificate) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	slaveCertificateUpdateCacheMut.RLock()
+	cache, cached := slaveCertificateUpdateCache[key]
+	slaveCertificateUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			slaveCertificateAllColumns,
+			slaveCertificatePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update slave_certificate, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"kentix\".\"slave_certificate\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, slaveCertificatePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(slaveCertificateType, slaveCertificateMapping, append(wl, slaveCertificatePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update slave_certificate row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for slave_certificate")
+	}
+
+	if !cached {
+		slaveCertificateUpdateCacheMut.Lock()
+		slaveCertificateUpdateCache[key] = cache
+		slaveCertificateUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q slaveCertificateQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q slaveCertificateQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for slave_certificate")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for slave_certificate")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o SlaveCertificateSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SlaveCertificateSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), slaveCertificatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"kentix\".\"slave_certificate\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, slaveCertificatePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in slaveCertificate slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all slaveCertificate")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *SlaveCertificate) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SlaveCertificate) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("appdb: no slave_certificate provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(slaveCertificateColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	slaveCertificateUpsertCacheMut.RLock()
+	cache, cached := slaveCertificateUpsertCache[key]
+	slaveCertificateUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			slaveCertificateAllColumns,
+			slaveCertificateColumnsWithDefault,
+			slaveCertificateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			slaveCertificateAllColumns,
+			slaveCertificatePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert slave_certificate, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(slaveCertificateAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(slaveCertificatePrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert slave_certificate, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(slaveCertificatePrimaryKeyColumns))
+			copy(conflict, slaveCertificatePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"kentix\".\"slave_certificate\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(slaveCertificateType, slaveCertificateMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(slaveCertificateType, slaveCertificateMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert slave_certificate")
+	}
+
+	if !cached {
+		slaveCertificateUpsertCacheMut.Lock()
+		slaveCertificateUpsertCache[key] = cache
+		slaveCertificateUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single SlaveCertificate record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *SlaveCertificate) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single SlaveCertificate record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SlaveCertificate) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no SlaveCertificate provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), slaveCertificatePrimaryKeyMapping)
+	sql := "DELETE FROM \"kentix\".\"slave_certificate\" WHERE \"configuration_id\"=$1 AND \"serial_number\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from slave_certificate")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for slave_certificate")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q slaveCertificateQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q slaveCertificateQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no slaveCertificateQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from slave_certificate")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for slave_certificate")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o SlaveCertificateSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SlaveCertificateSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(slaveCertificateBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), slaveCertificatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"kentix\".\"slave_certificate\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, slaveCertificatePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from slaveCertificate slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for slave_certificate")
+	}
+
+	if len(slaveCertificateAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *SlaveCertificate) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no SlaveCertificate provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SlaveCertificate) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSlaveCertificate(ctx, exec, o.ConfigurationID, o.SerialNumber)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SlaveCertificateSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty SlaveCertificateSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SlaveCertificateSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SlaveCertificateSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), slaveCertificatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"kentix\".\"slave_certificate\".* FROM \"kentix\".\"slave_certificate\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, slaveCertificatePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in SlaveCertificateSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SlaveCertificateExistsG checks if the SlaveCertificate row exists.
+func SlaveCertificateExistsG(ctx context.Context, configurationID int64, serialNumber string) (bool, error) {
+	return SlaveCertificateExists(ctx, boil.GetContextDB(), configurationID, serialNumber)
+}
+
+// SlaveCertificateExists checks if the SlaveCertificate row exists.
+func SlaveCertificateExists(ctx context.Context, exec boil.ContextExecutor, configurationID int64, serialNumber string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"kentix\".\"slave_certificate\" where \"configuration_id\"=$1 AND \"serial_number\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, configurationID, serialNumber)
+	}
+	row := exec.QueryRowContext(ctx, sql, configurationID, serialNumber)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if slave_certificate exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the SlaveCertificate row exists.
+func (o *SlaveCertificate) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return SlaveCertificateExists(ctx, exec, o.ConfigurationID, o.SerialNumber)
+}
@@ -0,0 +1,1222 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// SlaveStatus is an object representing the database table.
+type SlaveStatus struct {
+	ConfigurationID     int64  `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	SerialNumber        string `boil:"serial_number" json:"serial_number" toml:"serial_number" yaml:"serial_number"`
+	ConsecutiveFailures int32  `boil:"consecutive_failures" json:"consecutive_failures" toml:"consecutive_failures" yaml:"consecutive_failures"`
+
+	R *slaveStatusR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L slaveStatusL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SlaveStatusColumns = struct {
+	ConfigurationID     string
+	SerialNumber        string
+	ConsecutiveFailures string
+}{
+	ConfigurationID:     "configuration_id",
+	SerialNumber:        "serial_number",
+	ConsecutiveFailures: "consecutive_failures",
+}
+
+var SlaveStatusTableColumns = struct {
+	ConfigurationID     string
+	SerialNumber        string
+	ConsecutiveFailures string
+}{
+	ConfigurationID:     "slave_status.configuration_id",
+	SerialNumber:        "slave_status.serial_number",
+	ConsecutiveFailures: "slave_status.consecutive_failures",
+}
+
+// Generated where
+
+var SlaveStatusWhere = struct {
+	ConfigurationID     whereHelperint64
+	SerialNumber        whereHelperstring
+	ConsecutiveFailures whereHelperint32
+}{
+	ConfigurationID:     whereHelperint64{field: "\"kentix\".\"slave_status\".\"configuration_id\""},
+	SerialNumber:        whereHelperstring{field: "\"kentix\".\"slave_status\".\"serial_number\""},
+	ConsecutiveFailures: whereHelperint32{field: "\"kentix\".\"slave_status\".\"consecutive_failures\""},
+}
+
+// SlaveStatusRels is where relationship names are stored.
+var SlaveStatusRels = struct {
+	Configuration string
+}{
+	Configuration: "Configuration",
+}
+
+// slaveStatusR is where relationships are stored.
+type slaveStatusR struct {
+	Configuration *Configuration `boil:"Configuration" json:"Configuration" toml:"Configuration" yaml:"Configuration"`
+}
+
+// NewStruct creates a new relationship struct
+func (*slaveStatusR) NewStruct() *slaveStatusR {
+	return &slaveStatusR{}
+}
+
+func (r *slaveStatusR) GetConfiguration() *Configuration {
+	if r == nil {
+		return nil
+	}
+	return r.Configuration
+}
+
+// slaveStatusL is where Load methods for each relationship are stored.
+type slaveStatusL struct{}
+
+var (
+	slaveStatusAllColumns            = []string{"configuration_id", "serial_number", "consecutive_failures"}
+	slaveStatusColumnsWithoutDefault = []string{"configuration_id", "serial_number"}
+	slaveStatusColumnsWithDefault    = []string{"consecutive_failures"}
+	slaveStatusPrimaryKeyColumns     = []string{"configuration_id", "serial_number"}
+	slaveStatusGeneratedColumns      = []string{}
+)
+
+type (
+	// SlaveStatusSlice is an alias for a slice of pointers to SlaveStatus.
+	// This should almost always be used instead of []SlaveStatus.
+	SlaveStatusSlice []*SlaveStatus
+	// SlaveStatusHook is the signature for custom SlaveStatus hook methods
+	SlaveStatusHook func(context.Context, boil.ContextExecutor, *SlaveStatus) error
+
+	slaveStatusQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	slaveStatusType                 = reflect.TypeOf(&SlaveStatus{})
+	slaveStatusMapping              = queries.MakeStructMapping(slaveStatusType)
+	slaveStatusPrimaryKeyMapping, _ = queries.BindMapping(slaveStatusType, slaveStatusMapping, slaveStatusPrimaryKeyColumns)
+	slaveStatusInsertCacheMut       sync.RWMutex
+	slaveStatusInsertCache          = make(map[string]insertCache)
+	slaveStatusUpdateCacheMut       sync.RWMutex
+	slaveStatusUpdateCache          = make(map[string]updateCache)
+	slaveStatusUpsertCacheMut       sync.RWMutex
+	slaveStatusUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var slaveStatusAfterSelectMu sync.Mutex
+var slaveStatusAfterSelectHooks []SlaveStatusHook
+
+var slaveStatusBeforeInsertMu sync.Mutex
+var slaveStatusBeforeInsertHooks []SlaveStatusHook
+var slaveStatusAfterInsertMu sync.Mutex
+var slaveStatusAfterInsertHooks []SlaveStatusHook
+
+var slaveStatusBeforeUpdateMu sync.Mutex
+var slaveStatusBeforeUpdateHooks []SlaveStatusHook
+var slaveStatusAfterUpdateMu sync.Mutex
+var slaveStatusAfterUpdateHooks []SlaveStatusHook
+
+var slaveStatusBeforeDeleteMu sync.Mutex
+var slaveStatusBeforeDeleteHooks []SlaveStatusHook
+var slaveStatusAfterDeleteMu sync.Mutex
+var slaveStatusAfterDeleteHooks []SlaveStatusHook
+
+var slaveStatusBeforeUpsertMu sync.Mutex
+var slaveStatusBeforeUpsertHooks []SlaveStatusHook
+var slaveStatusAfterUpsertMu sync.Mutex
+var slaveStatusAfterUpsertHooks []SlaveStatusHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *SlaveStatus) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range slaveStatusAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *SlaveStatus) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range slaveStatusBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *SlaveStatus) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range slaveStatusAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *SlaveStatus) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range slaveStatusBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *SlaveStatus) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range slaveStatusAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *SlaveStatus) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range slaveStatusBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *SlaveStatus) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range slaveStatusAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *SlaveStatus) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range slaveStatusBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *SlaveStatus) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range slaveStatusAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSlaveStatusHook registers your hook function for all future operations.
+func AddSlaveStatusHook(hookPoint boil.HookPoint, slaveStatusHook SlaveStatusHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		slaveStatusAfterSelectMu.Lock()
+		slaveStatusAfterSelectHooks = append(slaveStatusAfterSelectHooks, slaveStatusHook)
+		slaveStatusAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		slaveStatusBeforeInsertMu.Lock()
+		slaveStatusBeforeInsertHooks = append(slaveStatusBeforeInsertHooks, slaveStatusHook)
+		slaveStatusBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		slaveStatusAfterInsertMu.Lock()
+		slaveStatusAfterInsertHooks = append(slaveStatusAfterInsertHooks, slaveStatusHook)
+		slaveStatusAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		slaveStatusBeforeUpdateMu.Lock()
+		slaveStatusBeforeUpdateHooks = append(slaveStatusBeforeUpdateHooks, slaveStatusHook)
+		slaveStatusBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		slaveStatusAfterUpdateMu.Lock()
+		slaveStatusAfterUpdateHooks = append(slaveStatusAfterUpdateHooks, slaveStatusHook)
+		slaveStatusAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		slaveStatusBeforeDeleteMu.Lock()
+		slaveStatusBeforeDeleteHooks = append(slaveStatusBeforeDeleteHooks, slaveStatusHook)
+		slaveStatusBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		slaveStatusAfterDeleteMu.Lock()
+		slaveStatusAfterDeleteHooks = append(slaveStatusAfterDeleteHooks, slaveStatusHook)
+		slaveStatusAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		slaveStatusBeforeUpsertMu.Lock()
+		slaveStatusBeforeUpsertHooks = append(slaveStatusBeforeUpsertHooks, slaveStatusHook)
+		slaveStatusBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		slaveStatusAfterUpsertMu.Lock()
+		slaveStatusAfterUpsertHooks = append(slaveStatusAfterUpsertHooks, slaveStatusHook)
+		slaveStatusAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single slaveStatus record from the query using the global executor.
+func (q slaveStatusQuery) OneG(ctx context.Context) (*SlaveStatus, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single slaveStatus record from the query.
+func (q slaveStatusQuery) One(ctx context.Context, exec boil.ContextExecutor) (*SlaveStatus, error) {
+	o := &SlaveStatus{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for slave_status")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all SlaveStatus records from the query using the global executor.
+func (q slaveStatusQuery) AllG(ctx context.Context) (SlaveStatusSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all SlaveStatus records from the query.
+func (q slaveStatusQuery) All(ctx context.Context, exec boil.ContextExecutor) (SlaveStatusSlice, error) {
+	var o []*SlaveStatus
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to SlaveStatus slice")
+	}
+
+	if len(slaveStatusAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all SlaveStatus records in the query using the global executor
+func (q slaveStatusQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all SlaveStatus records in the query.
+func (q slaveStatusQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count slave_status rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q slaveStatusQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q slaveStatusQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if slave_status exists")
+	}
+
+	return count > 0, nil
+}
+
+// Configuration pointed to by the foreign key.
+func (o *SlaveStatus) Configuration(mods ...qm.QueryMod) configurationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ConfigurationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Configurations(queryMods...)
+}
+
+// LoadConfiguration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (slaveStatusL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSlaveStatus interface{}, mods queries.Applicator) error {
+	var slice []*SlaveStatus
+	var object *SlaveStatus
+
+	if singular {
+		var ok bool
+		object, ok = maybeSlaveStatus.(*SlaveStatus)
+		if !ok {
+			object = new(SlaveStatus)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSlaveStatus)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSlaveStatus))
+			}
+		}
+	} else {
+		s, ok := maybeSlaveStatus.(*[]*SlaveStatus)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSlaveStatus)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSlaveStatus))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &slaveStatusR{}
+		}
+		args[object.ConfigurationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &slaveStatusR{}
+			}
+
+			args[obj.ConfigurationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`kentix.configuration`),
+		qm.WhereIn(`kentix.configuration.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Configuration")
+	}
+
+	var resultSlice []*Configuration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Configuration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for configuration")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for configuration")
+	}
+
+	if len(configurationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Configuration = foreign
+		if foreign.R == nil {
+			foreign.R = &configurationR{}
+		}
+		foreign.R.SlaveStatuses = append(foreign.R.SlaveStatuses, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ConfigurationID == foreign.ID {
+				local.R.Configuration = foreign
+				if foreign.R == nil {
+					foreign.R = &configurationR{}
+				}
+				foreign.R.SlaveStatuses = append(foreign.R.SlaveStatuses, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetConfigurationG of the slaveStatus to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.SlaveStatuses.
+// Uses the global database handle.
+func (o *SlaveStatus) SetConfigurationG(ctx context.Context, insert bool, related *Configuration) error {
+	return o.SetConfiguration(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetConfiguration of the slaveStatus to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.SlaveStatuses.
+func (o *SlaveStatus) SetConfiguration(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Configuration) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"kentix\".\"slave_status\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+		strmangle.WhereClause("\"", "\"", 2, slaveStatusPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ConfigurationID, o.SerialNumber}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ConfigurationID = related.ID
+	if o.R == nil {
+		o.R = &slaveStatusR{
+			Configuration: related,
+		}
+	} else {
+		o.R.Configuration = related
+	}
+
+	if related.R == nil {
+		related.R = &configurationR{
+			SlaveStatuses: SlaveStatusSlice{o},
+		}
+	} else {
+		related.R.SlaveStatuses = append(related.R.SlaveStatuses, o)
+	}
+
+	return nil
+}
+
+// SlaveStatuses retrieves all the records using an executor.
+func SlaveStatuses(mods ...qm.QueryMod) slaveStatusQuery {
+	mods = append(mods, qm.From("\"kentix\".\"slave_status\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"kentix\".\"slave_status\".*"})
+	}
+
+	return slaveStatusQuery{q}
+}
+
+// FindSlaveStatusG retrieves a single record by ID.
+func FindSlaveStatusG(ctx context.Context, configurationID int64, serialNumber string, selectCols ...string) (*SlaveStatus, error) {
+	return FindSlaveStatus(ctx, boil.GetContextDB(), configurationID, serialNumber, selectCols...)
+}
+
+// FindSlaveStatus retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSlaveStatus(ctx context.Context, exec boil.ContextExecutor, configurationID int64, serialNumber string, selectCols ...string) (*SlaveStatus, error) {
+	slaveStatusObj := &SlaveStatus{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"kentix\".\"slave_status\" where \"configuration_id\"=$1 AND \"serial_number\"=$2", sel,
+	)
+
+	q := queries.Raw(query, configurationID, serialNumber)
+
+	err := q.Bind(ctx, exec, slaveStatusObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from slave_status")
+	}
+
+	if err = slaveStatusObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return slaveStatusObj, err
+	}
+
+	return slaveStatusObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *SlaveStatus) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SlaveStatus) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no slave_status provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(slaveStatusColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	slaveStatusInsertCacheMut.RLock()
+	cache, cached := slaveStatusInsertCache[key]
+	slaveStatusInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			slaveStatusAllColumns,
+			slaveStatusColumnsWithDefault,
+			slaveStatusColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(slaveStatusType, slaveStatusMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(slaveStatusType, slaveStatusMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"kentix\".\"slave_status\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"kentix\".\"slave_status\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into slave_status")
+	}
+
+	if !cached {
+		slaveStatusInsertCacheMut.Lock()
+		slaveStatusInsertCache[key] = cache
+		slaveStatusInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single SlaveStatus record using the global executor.
+// See Update for more documentation.
+func (o *SlaveStatus) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the SlaveStatus.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SlaveStatus) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	slaveStatusUpdateCacheMut.RLock()
+	cache, cached := slaveStatusUpdateCache[key]
+	slaveStatusUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			slaveStatusAllColumns,
+			slaveStatusPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update slave_status, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"kentix\".\"slave_status\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, slaveStatusPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(slaveStatusType, slaveStatusMapping, append(wl, slaveStatusPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update slave_status row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for slave_status")
+	}
+
+	if !cached {
+		slaveStatusUpdateCacheMut.Lock()
+		slaveStatusUpdateCache[key] = cache
+		slaveStatusUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q slaveStatusQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q slaveStatusQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for slave_status")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for slave_status")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o SlaveStatusSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SlaveStatusSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), slaveStatusPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"kentix\".\"slave_status\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, slaveStatusPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in slaveStatus slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all slaveStatus")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *SlaveStatus) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SlaveStatus) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("appdb: no slave_status provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(slaveStatusColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	slaveStatusUpsertCacheMut.RLock()
+	cache, cached := slaveStatusUpsertCache[key]
+	slaveStatusUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			slaveStatusAllColumns,
+			slaveStatusColumnsWithDefault,
+			slaveStatusColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			slaveStatusAllColumns,
+			slaveStatusPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert slave_status, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(slaveStatusAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(slaveStatusPrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert slave_status, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(slaveStatusPrimaryKeyColumns))
+			copy(conflict, slaveStatusPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"kentix\".\"slave_status\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(slaveStatusType, slaveStatusMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(slaveStatusType, slaveStatusMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert slave_status")
+	}
+
+	if !cached {
+		slaveStatusUpsertCacheMut.Lock()
+		slaveStatusUpsertCache[key] = cache
+		slaveStatusUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single SlaveStatus record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *SlaveStatus) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single SlaveStatus record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SlaveStatus) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no SlaveStatus provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), slaveStatusPrimaryKeyMapping)
+	sql := "DELETE FROM \"kentix\".\"slave_status\" WHERE \"configuration_id\"=$1 AND \"serial_number\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from slave_status")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for slave_status")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q slaveStatusQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q slaveStatusQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no slaveStatusQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from slave_status")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for slave_status")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o SlaveStatusSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SlaveStatusSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(slaveStatusBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), slaveStatusPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"kentix\".\"slave_status\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, slaveStatusPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from slaveStatus slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for slave_status")
+	}
+
+	if len(slaveStatusAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *SlaveStatus) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no SlaveStatus provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SlaveStatus) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSlaveStatus(ctx, exec, o.ConfigurationID, o.SerialNumber)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SlaveStatusSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty SlaveStatusSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SlaveStatusSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SlaveStatusSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), slaveStatusPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"kentix\".\"slave_status\".* FROM \"kentix\".\"slave_status\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, slaveStatusPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in SlaveStatusSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SlaveStatusExistsG checks if the SlaveStatus row exists.
+func SlaveStatusExistsG(ctx context.Context, configurationID int64, serialNumber string) (bool, error) {
+	return SlaveStatusExists(ctx, boil.GetContextDB(), configurationID, serialNumber)
+}
+
+// SlaveStatusExists checks if the SlaveStatus row exists.
+func SlaveStatusExists(ctx context.Context, exec boil.ContextExecutor, configurationID int64, serialNumber string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"kentix\".\"slave_status\" where \"configuration_id\"=$1 AND \"serial_number\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, configurationID, serialNumber)
+	}
+	row := exec.QueryRowContext(ctx, sql, configurationID, serialNumber)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if slave_status exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the SlaveStatus row exists.
+func (o *SlaveStatus) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return SlaveStatusExists(ctx, exec, o.ConfigurationID, o.SerialNumber)
+}
@@ -17,62 +17,216 @@ package conf
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"kentix/apiserver"
 	"kentix/appdb"
+	"time"
 
 	"github.com/eliona-smart-building-assistant/go-utils/common"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 var ErrBadRequest = errors.New("bad request")
 
-// InsertConfig inserts or updates
+// ErrNotFound is returned if there is no configuration with the requested ID.
+var ErrNotFound = errors.New("not found")
+
+// InsertConfig inserts or updates. Updates keep the stored value of each omitted field, so that e.g. a
+// pinned certificate isn't replaced on the next trust on first use. Updates with the masked API key
+// or webhook secret keep the stored secret as well.
 func InsertConfig(ctx context.Context, config apiserver.Configuration) (apiserver.Configuration, error) {
-	dbConfig := dbConfigFromApiConfig(config)
-	err := dbConfig.InsertG(ctx, boil.Infer())
+	if config.Id == nil {
+		dbConfig, err := dbConfigFromApiConfig(config)
+		if err != nil {
+			return apiserver.Configuration{}, err
+		}
+		if err := dbConfig.InsertG(ctx, boil.Infer()); err != nil {
+			return apiserver.Configuration{}, err
+		}
+		return apiConfigFromDbConfig(&dbConfig)
+	}
+
+	dbConfig, _, err := mergeStoredConfig(ctx, config)
+	if err != nil {
+		return apiserver.Configuration{}, err
+	}
+	if err := dbConfig.UpsertG(ctx, true, []string{appdb.ConfigurationColumns.ID}, boil.Infer(), boil.Infer()); err != nil {
+		return apiserver.Configuration{}, err
+	}
+	return apiConfigFromDbConfig(&dbConfig)
+}
+
+// WithStoredFields completes an edited configuration with the stored values of the fields omitted
+// or sent masked, e.g. to test it before saving. The stored API key is only filled in for the stored
+// device, so that it cannot be sent to another host by changing the address or TLS settings.
+func WithStoredFields(ctx context.Context, config apiserver.Configuration) (apiserver.Configuration, error) {
+	if config.Id == nil {
+		return config, nil
+	}
+	dbConfig, existing, err := mergeStoredConfig(ctx, config)
 	if err != nil {
 		return apiserver.Configuration{}, err
 	}
-	return config, err
+	if err := checkStoredApiKeyUse(config, dbConfig, existing); err != nil {
+		return apiserver.Configuration{}, err
+	}
+	return apiConfigFromDbConfig(&dbConfig)
+}
+
+// checkStoredApiKeyUse rejects a configuration which takes the stored API key but connects to
+// another address or with other TLS settings than the stored configuration.
+func checkStoredApiKeyUse(config apiserver.Configuration, merged appdb.Configuration, stored *appdb.Configuration) error {
+	usesStoredApiKey := config.ApiKey == "" || config.ApiKey == MaskedSecret
+	if stored == nil || !usesStoredApiKey || sameDevice(merged, *stored) {
+		return nil
+	}
+	return fmt.Errorf("%w: the API key is required for another address or TLS settings than the stored ones", ErrBadRequest)
+}
+
+// sameDevice tells whether the configuration connects to the same device in the same way as the
+// stored one.
+func sameDevice(config appdb.Configuration, stored appdb.Configuration) bool {
+	return config.Address == stored.Address &&
+		config.Insecure == stored.Insecure &&
+		config.CaCertificate == stored.CaCertificate &&
+		config.CertificateFingerprint == stored.CertificateFingerprint &&
+		config.TrustOnFirstUse == stored.TrustOnFirstUse
+}
+
+// mergeStoredConfig converts the configuration and fills in the stored values of the fields omitted
+// or sent masked. It also returns the stored configuration, which is nil for a new configuration.
+func mergeStoredConfig(ctx context.Context, config apiserver.Configuration) (appdb.Configuration, *appdb.Configuration, error) {
+	if config.ApiKey == MaskedSecret {
+		config.ApiKey = ""
+	}
+	if config.WebhookSecret != nil && *config.WebhookSecret == MaskedSecret {
+		config.WebhookSecret = nil
+	}
+	dbConfig, err := dbConfigFromApiConfig(config)
+	if err != nil {
+		return appdb.Configuration{}, nil, err
+	}
+	existing, err := appdb.FindConfigurationG(ctx, dbConfig.ID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return appdb.Configuration{}, nil, fmt.Errorf("looking up config in DB: %v", err)
+	}
+	if existing != nil {
+		keepOmittedFields(config, &dbConfig, existing)
+	}
+	return dbConfig, existing, nil
+}
+
+// keepOmittedFields copies the stored values of the fields omitted in the update.
+func keepOmittedFields(config apiserver.Configuration, dbConfig *appdb.Configuration, existing *appdb.Configuration) {
+	if config.ApiKey == "" {
+		dbConfig.APIKey = existing.APIKey
+	}
+	if config.Enable == nil {
+		dbConfig.Enable = existing.Enable
+	}
+	if config.RequestTimeout == nil {
+		dbConfig.RequestTimeout = existing.RequestTimeout
+	}
+	if config.Active == nil {
+		dbConfig.Active = existing.Active
+	}
+	if config.ProjectIDs == nil {
+		dbConfig.ProjectIds = existing.ProjectIds
+	}
+	if config.WebhookSecret == nil {
+		dbConfig.WebhookSecret = existing.WebhookSecret
+	}
+	if config.CaCertificate == nil {
+		dbConfig.CaCertificate = existing.CaCertificate
+	}
+	if config.CertificateFingerprint == nil {
+		dbConfig.CertificateFingerprint = existing.CertificateFingerprint
+	}
+	if config.TrustOnFirstUse == nil {
+		dbConfig.TrustOnFirstUse = existing.TrustOnFirstUse
+	}
+	if config.Insecure == nil {
+		dbConfig.Insecure = existing.Insecure
+	}
+	if config.OfflineAlarmThreshold == nil {
+		dbConfig.OfflineAlarmThreshold = existing.OfflineAlarmThreshold
+	}
 }
 
 func GetConfig(ctx context.Context, configID int64) (*apiserver.Configuration, error) {
 	dbConfig, err := appdb.Configurations(
 		appdb.ConfigurationWhere.ID.EQ(configID),
 	).OneG(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
 	if err != nil {
 		return nil, fmt.Errorf("fetching config from database")
 	}
-	if dbConfig == nil {
-		return nil, ErrBadRequest
+	apiConfig, err := apiConfigFromDbConfig(dbConfig)
+	if err != nil {
+		return nil, err
 	}
-	apiConfig := apiConfigFromDbConfig(dbConfig)
 	return &apiConfig, nil
 }
 
-func DeleteConfig(ctx context.Context, configID int64) error {
+// DeleteConfig deletes the configuration together with its poll state. It returns the webhook the
+// app registered on the device for the configuration, which is only removed from the device once the
+// configuration is deleted.
+func DeleteConfig(ctx context.Context, configID int64) (*WebhookRegistration, error) {
+	tx, err := boil.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("beginning transaction: %v", err)
+	}
+	defer tx.Rollback()
+	var registration *WebhookRegistration
+	dbWebhook, err := appdb.FindWebhook(ctx, tx, configID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("looking up webhook in DB: %v", err)
+	}
+	if dbWebhook != nil {
+		registration = &WebhookRegistration{
+			WebhookID:  dbWebhook.WebhookID,
+			URL:        dbWebhook.URL,
+			SecretHash: dbWebhook.SecretHash.String,
+		}
+	}
 	count, err := appdb.Configurations(
 		appdb.ConfigurationWhere.ID.EQ(configID),
-	).DeleteAllG(ctx)
+	).DeleteAll(ctx, tx)
 	if err != nil {
-		return fmt.Errorf("fetching config from database")
+		return nil, fmt.Errorf("fetching config from database")
 	}
 	if count > 1 {
-		return fmt.Errorf("shouldn't happen: deleted more (%v) configs by ID", count)
+		return nil, fmt.Errorf("shouldn't happen: deleted more (%v) configs by ID", count)
 	}
 	if count == 0 {
-		return ErrBadRequest
+		return nil, ErrBadRequest
 	}
-	return nil
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing deletion of config: %v", err)
+	}
+	return registration, nil
 }
 
-func dbConfigFromApiConfig(apiConfig apiserver.Configuration) (dbConfig appdb.Configuration) {
+func dbConfigFromApiConfig(apiConfig apiserver.Configuration) (dbConfig appdb.Configuration, err error) {
+	apiKey, err := encryptSecret(apiConfig.ApiKey)
+	if err != nil {
+		return dbConfig, fmt.Errorf("encrypting API key: %v", err)
+	}
+	webhookSecret, err := encryptSecret(null.StringFromPtr(apiConfig.WebhookSecret).String)
+	if err != nil {
+		return dbConfig, fmt.Errorf("encrypting webhook secret: %v", err)
+	}
 	dbConfig.ID = null.Int64FromPtr(apiConfig.Id).Int64
 	dbConfig.Address = null.StringFrom(apiConfig.Address)
-	dbConfig.APIKey = null.StringFrom(apiConfig.ApiKey)
+	dbConfig.APIKey = null.StringFrom(apiKey)
 	dbConfig.Enable = null.BoolFromPtr(apiConfig.Enable)
 	dbConfig.RefreshInterval = apiConfig.RefreshInterval
 	if apiConfig.RequestTimeout != nil {
@@ -82,19 +236,45 @@ func dbConfigFromApiConfig(apiConfig apiserver.Configuration) (dbConfig appdb.Co
 	if apiConfig.ProjectIDs != nil {
 		dbConfig.ProjectIds = *apiConfig.ProjectIDs
 	}
-	return dbConfig
+	if apiConfig.WebhookSecret != nil {
+		dbConfig.WebhookSecret = null.StringFrom(webhookSecret)
+	}
+	dbConfig.CaCertificate = null.StringFromPtr(apiConfig.CaCertificate)
+	dbConfig.CertificateFingerprint = null.StringFromPtr(apiConfig.CertificateFingerprint)
+	dbConfig.TrustOnFirstUse = null.BoolFromPtr(apiConfig.TrustOnFirstUse)
+	dbConfig.Insecure = null.BoolFromPtr(apiConfig.Insecure)
+	if apiConfig.OfflineAlarmThreshold != nil {
+		dbConfig.OfflineAlarmThreshold = *apiConfig.OfflineAlarmThreshold
+	}
+	return dbConfig, nil
 }
 
-func apiConfigFromDbConfig(dbConfig *appdb.Configuration) (apiConfig apiserver.Configuration) {
+func apiConfigFromDbConfig(dbConfig *appdb.Configuration) (apiConfig apiserver.Configuration, err error) {
+	apiKey, _, err := decryptSecret(dbConfig.APIKey.String)
+	if err != nil {
+		return apiConfig, fmt.Errorf("decrypting API key of config %d: %v", dbConfig.ID, err)
+	}
+	if dbConfig.WebhookSecret.Valid {
+		webhookSecret, _, err := decryptSecret(dbConfig.WebhookSecret.String)
+		if err != nil {
+			return apiConfig, fmt.Errorf("decrypting webhook secret of config %d: %v", dbConfig.ID, err)
+		}
+		apiConfig.WebhookSecret = &webhookSecret
+	}
 	apiConfig.Id = &dbConfig.ID
 	apiConfig.Address = dbConfig.Address.String
-	apiConfig.ApiKey = dbConfig.APIKey.String
+	apiConfig.ApiKey = apiKey
 	apiConfig.Enable = dbConfig.Enable.Ptr()
 	apiConfig.RefreshInterval = dbConfig.RefreshInterval
 	apiConfig.RequestTimeout = &dbConfig.RequestTimeout
 	apiConfig.Active = dbConfig.Active.Ptr()
 	apiConfig.ProjectIDs = common.Ptr[[]string](dbConfig.ProjectIds)
-	return apiConfig
+	apiConfig.CaCertificate = dbConfig.CaCertificate.Ptr()
+	apiConfig.CertificateFingerprint = dbConfig.CertificateFingerprint.Ptr()
+	apiConfig.TrustOnFirstUse = dbConfig.TrustOnFirstUse.Ptr()
+	apiConfig.Insecure = dbConfig.Insecure.Ptr()
+	apiConfig.OfflineAlarmThreshold = &dbConfig.OfflineAlarmThreshold
+	return apiConfig, nil
 }
 
 func apiSensorFromDbSensor(ctx context.Context, dbSensor *appdb.Sensor) (apiSensor apiserver.Sensor, err error) {
@@ -103,7 +283,10 @@ func apiSensorFromDbSensor(ctx context.Context, dbSensor *appdb.Sensor) (apiSens
 	if err != nil {
 		return apiSensor, fmt.Errorf("fetching configuration for sensor: %v", err)
 	}
-	apiSensor.Configuration = apiConfigFromDbConfig(dbConfiguration)
+	apiSensor.Configuration, err = apiConfigFromDbConfig(dbConfiguration)
+	if err != nil {
+		return apiSensor, err
+	}
 	apiSensor.ProjectID = dbSensor.ProjectID
 	apiSensor.SerialNumber = dbSensor.SerialNumber
 	return apiSensor, nil
@@ -117,33 +300,65 @@ func GetConfigs(ctx context.Context) ([]apiserver.Configuration, error) {
 	var apiConfigs []apiserver.Configuration
 	for _, dbConfig := range dbConfigs {
 		dbConfig.R.GetSensors()
-		apiConfigs = append(apiConfigs, apiConfigFromDbConfig(dbConfig))
+		apiConfig, err := apiConfigFromDbConfig(dbConfig)
+		if err != nil {
+			return nil, err
+		}
+		apiConfigs = append(apiConfigs, apiConfig)
 	}
 	return apiConfigs, nil
 }
 
-func GetConfigSensors(ctx context.Context, config apiserver.Configuration) ([]apiserver.Sensor, error) {
-	if config.Id == nil {
-		return nil, fmt.Errorf("shouldn't happen: config ID is null")
+// EncryptSecrets encrypts all API keys and webhook secrets with the current encryption key. This
+// encrypts secrets stored in plain text by earlier versions and re-encrypts secrets after the
+// encryption key was rotated.
+func EncryptSecrets(ctx context.Context) error {
+	if _, err := encryptionKeys(); errors.Is(err, errNoEncryptionKey) {
+		log.Warn("conf", "API_KEY_ENCRYPTION_KEY is not set, API keys and webhook secrets are stored unencrypted")
+		return nil
+	} else if err != nil {
+		return err
 	}
-	dbSensors, err := appdb.Sensors(
-		appdb.SensorWhere.ConfigurationID.EQ(*config.Id),
-	).AllG(ctx)
+	dbConfigs, err := appdb.Configurations().AllG(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("looking up sensors in DB: %v", err)
+		return fmt.Errorf("fetching configs from database: %v", err)
 	}
-	if len(dbSensors) == 0 {
-		return nil, fmt.Errorf("no sensor found for config %v", config.Id)
-	}
-	var apiSensors []apiserver.Sensor
-	for _, dbSensor := range dbSensors {
-		s, err := apiSensorFromDbSensor(ctx, dbSensor)
+	for _, dbConfig := range dbConfigs {
+		apiKey, err := reencryptSecret(dbConfig.APIKey)
+		if err != nil {
+			return fmt.Errorf("re-encrypting API key of config %d: %v", dbConfig.ID, err)
+		}
+		webhookSecret, err := reencryptSecret(dbConfig.WebhookSecret)
 		if err != nil {
-			return nil, fmt.Errorf("creating API sensor from DB sensor: %v", err)
+			return fmt.Errorf("re-encrypting webhook secret of config %d: %v", dbConfig.ID, err)
+		}
+		if apiKey == dbConfig.APIKey && webhookSecret == dbConfig.WebhookSecret {
+			continue
+		}
+		dbConfig.APIKey = apiKey
+		dbConfig.WebhookSecret = webhookSecret
+		if _, err := dbConfig.UpdateG(ctx, boil.Whitelist(appdb.ConfigurationColumns.APIKey, appdb.ConfigurationColumns.WebhookSecret)); err != nil {
+			return fmt.Errorf("updating secrets of config %d: %v", dbConfig.ID, err)
 		}
-		apiSensors = append(apiSensors, s)
 	}
-	return apiSensors, nil
+	return nil
+}
+
+// reencryptSecret returns the stored secret encrypted with the current encryption key. Secrets
+// which are already encrypted with it are returned unchanged.
+func reencryptSecret(stored null.String) (null.String, error) {
+	secret, keyIndex, err := decryptSecret(stored.String)
+	if err != nil {
+		return stored, fmt.Errorf("decrypting: %v", err)
+	}
+	if keyIndex == 0 || secret == "" {
+		return stored, nil
+	}
+	encrypted, err := encryptSecret(secret)
+	if err != nil {
+		return stored, fmt.Errorf("encrypting: %v", err)
+	}
+	return null.StringFrom(encrypted), nil
 }
 
 func GetAssetId(ctx context.Context, config apiserver.Configuration, projId string, deviceId string) (*int32, error) {
@@ -158,13 +373,283 @@ func GetAssetId(ctx context.Context, config apiserver.Configuration, projId stri
 	return common.Ptr(dbSensors[0].AssetID.Int32), nil
 }
 
+// GetSensorByAssetId returns the sensor mapped to the given Eliona asset, or nil if the asset
+// doesn't belong to this app.
+func GetSensorByAssetId(ctx context.Context, assetId int32) (*apiserver.Sensor, error) {
+	dbSensor, err := appdb.Sensors(
+		appdb.SensorWhere.AssetID.EQ(null.Int32From(assetId)),
+	).OneG(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("looking up sensor in DB: %v", err)
+	}
+	apiSensor, err := apiSensorFromDbSensor(ctx, dbSensor)
+	if err != nil {
+		return nil, fmt.Errorf("creating API sensor from DB sensor: %v", err)
+	}
+	return &apiSensor, nil
+}
+
 func InsertSensor(ctx context.Context, config apiserver.Configuration, projId string, SerialNumber string, assetId int32) error {
 	var dbSensor appdb.Sensor
 	dbSensor.ConfigurationID = null.Int64FromPtr(config.Id).Int64
 	dbSensor.ProjectID = projId
 	dbSensor.SerialNumber = SerialNumber
 	dbSensor.AssetID = null.Int32From(assetId)
-	return dbSensor.InsertG(ctx, boil.Infer())
+	// Upserts, because the asset might have been created by another app instance which polled the
+	// device before.
+	return dbSensor.UpsertG(ctx, true, []string{appdb.SensorColumns.ConfigurationID, appdb.SensorColumns.ProjectID, appdb.SensorColumns.SerialNumber}, boil.Whitelist(appdb.SensorColumns.AssetID), boil.Infer())
+}
+
+// GetAccessLogCursor returns the ID of the last access event imported for the configuration.
+func GetAccessLogCursor(ctx context.Context, config apiserver.Configuration) (int64, error) {
+	dbCursor, err := appdb.FindAccessLogCursorG(ctx, null.Int64FromPtr(config.Id).Int64)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("looking up access log cursor in DB: %v", err)
+	}
+	return dbCursor.LastEventID, nil
+}
+
+// SetAccessLogCursor stores the ID of the last access event imported for the configuration.
+func SetAccessLogCursor(ctx context.Context, config apiserver.Configuration, lastEventId int64) error {
+	dbCursor := appdb.AccessLogCursor{
+		ConfigurationID: null.Int64FromPtr(config.Id).Int64,
+		LastEventID:     lastEventId,
+	}
+	return dbCursor.UpsertG(ctx, true, []string{appdb.AccessLogCursorColumns.ConfigurationID}, boil.Whitelist(appdb.AccessLogCursorColumns.LastEventID), boil.Infer())
+}
+
+// States of the circuit breaker which suspends polling of devices failing for a long time.
+const (
+	CircuitClosed   = "closed"
+	CircuitOpen     = "open"
+	CircuitHalfOpen = "half-open"
+)
+
+const (
+	// circuitBreakerThreshold is the number of failed polls in a row after which polling is suspended.
+	circuitBreakerThreshold = 5
+	// maxCircuitBackoff limits how long polling of a failing device is suspended.
+	maxCircuitBackoff = time.Hour
+)
+
+// GetConfigStatus returns the status of the latest polls of the configuration. Configurations which
+// were not polled yet have an empty status.
+func GetConfigStatus(ctx context.Context, config apiserver.Configuration) (apiserver.ConfigurationStatus, error) {
+	status := apiserver.ConfigurationStatus{ConfigurationId: config.Id, CircuitState: CircuitClosed}
+	dbStatus, err := appdb.FindConfigurationStatusG(ctx, null.Int64FromPtr(config.Id).Int64)
+	if errors.Is(err, sql.ErrNoRows) {
+		return status, nil
+	}
+	if err != nil {
+		return status, fmt.Errorf("looking up configuration status in DB: %v", err)
+	}
+	status.DeviceSerial = dbStatus.DeviceSerial.Ptr()
+	status.LastSuccessAt = dbStatus.LastSuccessAt.Ptr()
+	status.LastError = dbStatus.LastError.Ptr()
+	status.LastErrorAt = dbStatus.LastErrorAt.Ptr()
+	status.ConsecutiveFailures = dbStatus.ConsecutiveFailures
+	status.PollDurationMs = dbStatus.PollDurationMS
+	status.AssetsUpdated = dbStatus.AssetsUpdated
+	status.CircuitOpenUntil = dbStatus.CircuitOpenUntil.Ptr()
+	status.CircuitState = circuitState(dbStatus.CircuitOpenUntil)
+	return status, nil
+}
+
+// circuitState derives the state of the circuit breaker. Once the open circuit expires, the next
+// poll is a trial which either closes the circuit or opens it again for longer.
+func circuitState(openUntil null.Time) string {
+	switch {
+	case !openUntil.Valid:
+		return CircuitClosed
+	case time.Now().Before(openUntil.Time):
+		return CircuitOpen
+	default:
+		return CircuitHalfOpen
+	}
+}
+
+// circuitBackoff doubles the time polling is suspended with each failed poll beyond the threshold,
+// starting with the refresh interval.
+func circuitBackoff(config apiserver.Configuration, consecutiveFailures int32) time.Duration {
+	backoff := time.Duration(config.RefreshInterval) * time.Second
+	if backoff < time.Second {
+		backoff = time.Second
+	}
+	for i := int32(circuitBreakerThreshold); i < consecutiveFailures && backoff < maxCircuitBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxCircuitBackoff {
+		return maxCircuitBackoff
+	}
+	return backoff
+}
+
+// SetPollResult records the outcome of a poll of the configuration. A nil pollErr marks the poll
+// as successful and resets the consecutive failures. An empty deviceSerial keeps the stored one.
+// Once the poll failed too often in a row, the circuit breaker suspends polling.
+func SetPollResult(ctx context.Context, config apiserver.Configuration, deviceSerial string, duration time.Duration, assetsUpdated int, pollErr error) error {
+	dbStatus, err := appdb.FindConfigurationStatusG(ctx, null.Int64FromPtr(config.Id).Int64)
+	if errors.Is(err, sql.ErrNoRows) {
+		dbStatus = &appdb.ConfigurationStatus{ConfigurationID: null.Int64FromPtr(config.Id).Int64}
+	} else if err != nil {
+		return fmt.Errorf("looking up configuration status in DB: %v", err)
+	}
+	if deviceSerial != "" {
+		dbStatus.DeviceSerial = null.StringFrom(deviceSerial)
+	}
+	now := time.Now()
+	if pollErr == nil {
+		dbStatus.LastSuccessAt = null.TimeFrom(now)
+		dbStatus.ConsecutiveFailures = 0
+		dbStatus.CircuitOpenUntil = null.Time{}
+	} else {
+		dbStatus.LastError = null.StringFrom(pollErr.Error())
+		dbStatus.LastErrorAt = null.TimeFrom(now)
+		dbStatus.ConsecutiveFailures++
+		if dbStatus.ConsecutiveFailures >= circuitBreakerThreshold {
+			dbStatus.CircuitOpenUntil = null.TimeFrom(now.Add(circuitBackoff(config, dbStatus.ConsecutiveFailures)))
+		}
+	}
+	dbStatus.PollDurationMS = int32(duration.Milliseconds())
+	dbStatus.AssetsUpdated = int32(assetsUpdated)
+	return dbStatus.UpsertG(ctx, true, []string{appdb.ConfigurationStatusColumns.ConfigurationID}, boil.Infer(), boil.Infer())
+}
+
+// ResetCircuitBreaker closes the circuit breaker of the configuration, so that the device is polled
+// again right away, e.g. after its configuration was fixed.
+func ResetCircuitBreaker(ctx context.Context, configId int64) error {
+	_, err := appdb.ConfigurationStatuses(
+		appdb.ConfigurationStatusWhere.ConfigurationID.EQ(configId),
+	).UpdateAllG(ctx, appdb.M{
+		appdb.ConfigurationStatusColumns.CircuitOpenUntil: nil,
+	})
+	return err
+}
+
+// WebhookRegistration is a webhook the app registered on a Kentix device. The secret sent by the
+// device is only remembered as hash, to notice when it changed.
+type WebhookRegistration struct {
+	WebhookID  int32
+	URL        string
+	SecretHash string
+}
+
+// GetWebhookRegistration returns the webhook registered for the configuration, or nil if there is none.
+func GetWebhookRegistration(ctx context.Context, config apiserver.Configuration) (*WebhookRegistration, error) {
+	dbWebhook, err := appdb.FindWebhookG(ctx, null.Int64FromPtr(config.Id).Int64)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("looking up webhook in DB: %v", err)
+	}
+	return &WebhookRegistration{
+		WebhookID:  dbWebhook.WebhookID,
+		URL:        dbWebhook.URL,
+		SecretHash: dbWebhook.SecretHash.String,
+	}, nil
+}
+
+func SetWebhookRegistration(ctx context.Context, config apiserver.Configuration, registration WebhookRegistration) error {
+	dbWebhook := appdb.Webhook{
+		ConfigurationID: null.Int64FromPtr(config.Id).Int64,
+		WebhookID:       registration.WebhookID,
+		URL:             registration.URL,
+		SecretHash:      null.StringFrom(registration.SecretHash),
+	}
+	return dbWebhook.UpsertG(ctx, true, []string{appdb.WebhookColumns.ConfigurationID}, boil.Whitelist(appdb.WebhookColumns.WebhookID, appdb.WebhookColumns.URL, appdb.WebhookColumns.SecretHash), boil.Infer())
+}
+
+func DeleteWebhookRegistration(ctx context.Context, config apiserver.Configuration) error {
+	_, err := appdb.Webhooks(
+		appdb.WebhookWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+	).DeleteAllG(ctx)
+	return err
+}
+
+func SetWebhookSecret(ctx context.Context, config apiserver.Configuration, secret string) error {
+	encrypted, err := encryptSecret(secret)
+	if err != nil {
+		return fmt.Errorf("encrypting webhook secret: %v", err)
+	}
+	_, err = appdb.Configurations(
+		appdb.ConfigurationWhere.ID.EQ(null.Int64FromPtr(config.Id).Int64),
+	).UpdateAllG(ctx, appdb.M{
+		appdb.ConfigurationColumns.WebhookSecret: encrypted,
+	})
+	return err
+}
+
+func SetCertificateFingerprint(ctx context.Context, config apiserver.Configuration, fingerprint string) error {
+	_, err := appdb.Configurations(
+		appdb.ConfigurationWhere.ID.EQ(null.Int64FromPtr(config.Id).Int64),
+	).UpdateAllG(ctx, appdb.M{
+		appdb.ConfigurationColumns.CertificateFingerprint: fingerprint,
+	})
+	return err
+}
+
+// GetSlaveFingerprint returns the certificate fingerprint pinned for a slave of the configuration,
+// or nil if none is pinned yet.
+func GetSlaveFingerprint(ctx context.Context, config apiserver.Configuration, serial string) (*string, error) {
+	dbCertificate, err := appdb.FindSlaveCertificateG(ctx, null.Int64FromPtr(config.Id).Int64, serial)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("looking up slave certificate in DB: %v", err)
+	}
+	return &dbCertificate.Fingerprint, nil
+}
+
+func SetSlaveFingerprint(ctx context.Context, config apiserver.Configuration, serial string, fingerprint string) error {
+	dbCertificate := appdb.SlaveCertificate{
+		ConfigurationID: null.Int64FromPtr(config.Id).Int64,
+		SerialNumber:    serial,
+		Fingerprint:     fingerprint,
+	}
+	return dbCertificate.UpsertG(ctx, true, []string{appdb.SlaveCertificateColumns.ConfigurationID, appdb.SlaveCertificateColumns.SerialNumber}, boil.Whitelist(appdb.SlaveCertificateColumns.Fingerprint), boil.Infer())
+}
+
+// SetSlavePollResult records whether the slave of the configuration answered the poll and returns
+// the number of polls in a row it didn't answer.
+func SetSlavePollResult(ctx context.Context, config apiserver.Configuration, serial string, online bool) (int32, error) {
+	dbStatus, err := appdb.FindSlaveStatusG(ctx, null.Int64FromPtr(config.Id).Int64, serial)
+	if errors.Is(err, sql.ErrNoRows) {
+		dbStatus = &appdb.SlaveStatus{ConfigurationID: null.Int64FromPtr(config.Id).Int64, SerialNumber: serial}
+	} else if err != nil {
+		return 0, fmt.Errorf("looking up slave status in DB: %v", err)
+	}
+	if online {
+		dbStatus.ConsecutiveFailures = 0
+	} else {
+		dbStatus.ConsecutiveFailures++
+	}
+	if err := dbStatus.UpsertG(ctx, true, []string{appdb.SlaveStatusColumns.ConfigurationID, appdb.SlaveStatusColumns.SerialNumber}, boil.Whitelist(appdb.SlaveStatusColumns.ConsecutiveFailures), boil.Infer()); err != nil {
+		return 0, err
+	}
+	return dbStatus.ConsecutiveFailures, nil
+}
+
+// GetSlaveSerials returns the serial numbers of the slaves polled through the configuration so far.
+func GetSlaveSerials(ctx context.Context, config apiserver.Configuration) ([]string, error) {
+	dbStatuses, err := appdb.SlaveStatuses(
+		appdb.SlaveStatusWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+	).AllG(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching slave statuses from DB: %v", err)
+	}
+	serials := make([]string, 0, len(dbStatuses))
+	for _, dbStatus := range dbStatuses {
+		serials = append(serials, dbStatus.SerialNumber)
+	}
+	return serials, nil
 }
 
 func SetConfigActiveState(ctx context.Context, config apiserver.Configuration, state bool) (int64, error) {
@@ -190,8 +675,52 @@ func IsConfigEnabled(config apiserver.Configuration) bool {
 	return config.Enable == nil || *config.Enable
 }
 
-func SetAllConfigsInactive(ctx context.Context) (int64, error) {
-	return appdb.Configurations().UpdateAllG(ctx, appdb.M{
+// SetOwnedConfigsInactive sets the configurations polled by the app instance inactive. The
+// configurations of other instances stay active.
+func SetOwnedConfigsInactive(ctx context.Context, owner string) (int64, error) {
+	return appdb.Configurations(
+		qm.Where("id in (select configuration_id from kentix.poll_lease where owner = ?)", owner),
+	).UpdateAllG(ctx, appdb.M{
 		appdb.ConfigurationColumns.Active: false,
 	})
 }
+
+// AcquireLease acquires the lease of the app instance on the configuration, or renews it if the
+// instance already holds it. It returns false if another instance holds a lease which hasn't expired.
+// The expiry is computed by the database, so the clocks of the instances don't matter.
+func AcquireLease(ctx context.Context, configId int64, owner string, ttl time.Duration) (bool, error) {
+	var lease appdb.PollLease
+	err := queries.Raw(`
+		insert into kentix.poll_lease as lease (configuration_id, owner, expires_at)
+		values ($1, $2, now() + $3 * interval '1 millisecond')
+		on conflict (configuration_id) do update
+		set owner = excluded.owner, expires_at = excluded.expires_at
+		where lease.owner = excluded.owner or lease.expires_at < now()
+		returning *`,
+		configId, owner, ttl.Milliseconds(),
+	).Bind(ctx, boil.GetContextDB(), &lease)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("upserting lease in DB: %v", err)
+	}
+	return true, nil
+}
+
+// ReleaseLease gives up the lease of the app instance on the configuration.
+func ReleaseLease(ctx context.Context, configId int64, owner string) error {
+	_, err := appdb.PollLeases(
+		appdb.PollLeaseWhere.ConfigurationID.EQ(configId),
+		appdb.PollLeaseWhere.Owner.EQ(owner),
+	).DeleteAllG(ctx)
+	return err
+}
+
+// ReleaseLeases gives up all leases of the app instance.
+func ReleaseLeases(ctx context.Context, owner string) error {
+	_, err := appdb.PollLeases(
+		appdb.PollLeaseWhere.Owner.EQ(owner),
+	).DeleteAllG(ctx)
+	return err
+}
@@ -0,0 +1,74 @@
+//  This file is part of the eliona project.
+//  Copyright © 2023 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package conf
+
+import (
+	"errors"
+	"kentix/apiserver"
+	"kentix/appdb"
+	"testing"
+
+	"github.com/volatiletech/null/v8"
+)
+
+func TestCheckStoredApiKeyUse(t *testing.T) {
+	stored := appdb.Configuration{
+		Address:                null.StringFrom("https://kentix.local"),
+		Insecure:               null.BoolFrom(false),
+		CertificateFingerprint: null.StringFrom("AB:CD"),
+		TrustOnFirstUse:        null.BoolFrom(false),
+	}
+	withAddress := func(address string) appdb.Configuration {
+		merged := stored
+		merged.Address = null.StringFrom(address)
+		return merged
+	}
+	insecure := stored
+	insecure.Insecure = null.BoolFrom(true)
+	otherFingerprint := stored
+	otherFingerprint.CertificateFingerprint = null.StringFrom("EF:01")
+
+	tests := []struct {
+		name    string
+		apiKey  string
+		merged  appdb.Configuration
+		stored  *appdb.Configuration
+		wantErr bool
+	}{
+		{name: "stored key for stored device", apiKey: MaskedSecret, merged: stored, stored: &stored},
+		{name: "omitted key for stored device", apiKey: "", merged: stored, stored: &stored},
+		{name: "stored key for changed address", apiKey: MaskedSecret, merged: withAddress("https://attacker.example"), stored: &stored, wantErr: true},
+		{name: "omitted key for changed address", apiKey: "", merged: withAddress("https://attacker.example"), stored: &stored, wantErr: true},
+		{name: "stored key without certificate check", apiKey: MaskedSecret, merged: insecure, stored: &stored, wantErr: true},
+		{name: "stored key for other fingerprint", apiKey: MaskedSecret, merged: otherFingerprint, stored: &stored, wantErr: true},
+		{name: "new key for changed address", apiKey: "new-key", merged: withAddress("https://other.local"), stored: &stored},
+		{name: "unsaved config", apiKey: "", merged: stored, stored: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkStoredApiKeyUse(apiserver.Configuration{ApiKey: tt.apiKey}, tt.merged, tt.stored)
+			if tt.wantErr {
+				if !errors.Is(err, ErrBadRequest) {
+					t.Fatalf("err = %v, want %v", err, ErrBadRequest)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
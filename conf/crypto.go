@@ -0,0 +1,132 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package conf
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+)
+
+// MaskedSecret replaces API keys and webhook secrets in API responses. Configurations sent back with
+// this value keep their stored secret.
+const MaskedSecret = "********"
+
+// encryptedPrefix marks secrets stored encrypted. Secrets without it are stored in plain text by earlier versions.
+const encryptedPrefix = "enc:"
+
+var errNoEncryptionKey = errors.New("API_KEY_ENCRYPTION_KEY is not set")
+
+// encryptionKeys returns the current key from API_KEY_ENCRYPTION_KEY followed by the previous keys from
+// API_KEY_ENCRYPTION_OLD_KEYS. The keys are base64 encoded and 32 bytes long (AES-256).
+func encryptionKeys() ([][]byte, error) {
+	current := common.Getenv("API_KEY_ENCRYPTION_KEY", "")
+	if current == "" {
+		return nil, errNoEncryptionKey
+	}
+	encodedKeys := []string{current}
+	for _, old := range strings.Split(common.Getenv("API_KEY_ENCRYPTION_OLD_KEYS", ""), ",") {
+		if old = strings.TrimSpace(old); old != "" {
+			encodedKeys = append(encodedKeys, old)
+		}
+	}
+	var keys [][]byte
+	for i, encodedKey := range encodedKeys {
+		key, err := base64.StdEncoding.DecodeString(encodedKey)
+		if err != nil {
+			return nil, fmt.Errorf("decoding encryption key %d: %v", i, err)
+		}
+		if len(key) != 32 {
+			return nil, fmt.Errorf("encryption key %d has %d bytes instead of 32", i, len(key))
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// encryptSecret encrypts an API key or webhook secret with the current encryption key. Without
+// encryption key the secret is stored in plain text.
+func encryptSecret(secret string) (string, error) {
+	if secret == "" {
+		return "", nil
+	}
+	keys, err := encryptionKeys()
+	if errors.Is(err, errNoEncryptionKey) {
+		return secret, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	gcm, err := newGcm(keys[0])
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("generating nonce: %v", err)
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(secret), nil)
+	return encryptedPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// decryptSecret decrypts a stored secret with the current or one of the previous encryption keys.
+// It returns the index of the matching key as well, so secrets encrypted with a previous key can be
+// re-encrypted.
+func decryptSecret(stored string) (string, int, error) {
+	if !strings.HasPrefix(stored, encryptedPrefix) {
+		return stored, -1, nil
+	}
+	keys, err := encryptionKeys()
+	if err != nil {
+		return "", 0, err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(stored, encryptedPrefix))
+	if err != nil {
+		return "", 0, fmt.Errorf("decoding encrypted secret: %v", err)
+	}
+	for i, key := range keys {
+		gcm, err := newGcm(key)
+		if err != nil {
+			return "", 0, err
+		}
+		if len(sealed) < gcm.NonceSize() {
+			return "", 0, fmt.Errorf("encrypted secret is too short")
+		}
+		nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+		if secret, err := gcm.Open(nil, nonce, ciphertext, nil); err == nil {
+			return string(secret), i, nil
+		}
+	}
+	return "", 0, fmt.Errorf("no encryption key matches the encrypted secret")
+}
+
+func newGcm(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("creating cipher: %v", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("creating GCM: %v", err)
+	}
+	return gcm, nil
+}
@@ -0,0 +1,184 @@
+//  This file is part of the eliona project.
+//  Copyright © 2023 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package conf
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/volatiletech/null/v8"
+)
+
+var (
+	testKey    = base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+	testOldKey = base64.StdEncoding.EncodeToString([]byte("fedcba9876543210fedcba9876543210"))
+	testBadKey = base64.StdEncoding.EncodeToString([]byte("too short"))
+)
+
+func setEncryptionKeys(t *testing.T, current string, old string) {
+	t.Helper()
+	t.Setenv("API_KEY_ENCRYPTION_KEY", current)
+	t.Setenv("API_KEY_ENCRYPTION_OLD_KEYS", old)
+}
+
+// encryptWith encrypts the secret as if the given key was the current one.
+func encryptWith(t *testing.T, key string, secret string) string {
+	t.Helper()
+	setEncryptionKeys(t, key, "")
+	encrypted, err := encryptSecret(secret)
+	if err != nil {
+		t.Fatalf("encrypting secret: %v", err)
+	}
+	return encrypted
+}
+
+func TestEncryptSecret(t *testing.T) {
+	tests := []struct {
+		name          string
+		key           string
+		secret        string
+		wantEncrypted bool
+		wantErr       bool
+	}{
+		{name: "encrypts with key", key: testKey, secret: "api-key", wantEncrypted: true},
+		{name: "keeps plain text without key", key: "", secret: "api-key", wantEncrypted: false},
+		{name: "keeps empty secret", key: testKey, secret: "", wantEncrypted: false},
+		{name: "rejects key of wrong length", key: testBadKey, secret: "api-key", wantErr: true},
+		{name: "rejects key not base64 encoded", key: "not base64!", secret: "api-key", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setEncryptionKeys(t, tt.key, "")
+			encrypted, err := encryptSecret(tt.secret)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %q", encrypted)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := strings.HasPrefix(encrypted, encryptedPrefix); got != tt.wantEncrypted {
+				t.Fatalf("encrypted = %q, want encrypted %t", encrypted, tt.wantEncrypted)
+			}
+			if !tt.wantEncrypted && encrypted != tt.secret {
+				t.Fatalf("encrypted = %q, want %q", encrypted, tt.secret)
+			}
+			if tt.wantEncrypted && strings.Contains(encrypted, tt.secret) {
+				t.Fatalf("encrypted %q contains the secret", encrypted)
+			}
+		})
+	}
+}
+
+func TestEncryptSecretUsesFreshNonce(t *testing.T) {
+	setEncryptionKeys(t, testKey, "")
+	first, err := encryptSecret("api-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := encryptSecret("api-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first == second {
+		t.Fatalf("encrypting twice gave the same ciphertext %q", first)
+	}
+}
+
+func TestDecryptSecret(t *testing.T) {
+	encryptedWithKey := encryptWith(t, testKey, "api-key")
+	encryptedWithOldKey := encryptWith(t, testOldKey, "api-key")
+
+	tests := []struct {
+		name         string
+		key          string
+		oldKeys      string
+		stored       string
+		wantSecret   string
+		wantKeyIndex int
+		wantErr      bool
+	}{
+		{name: "decrypts with current key", key: testKey, stored: encryptedWithKey, wantSecret: "api-key", wantKeyIndex: 0},
+		{name: "decrypts with old key after rotation", key: testKey, oldKeys: testOldKey, stored: encryptedWithOldKey, wantSecret: "api-key", wantKeyIndex: 1},
+		{name: "finds old key in list", key: testKey, oldKeys: " " + testKey + " , " + testOldKey, stored: encryptedWithOldKey, wantSecret: "api-key", wantKeyIndex: 2},
+		{name: "returns plain text as is", key: testKey, stored: "api-key", wantSecret: "api-key", wantKeyIndex: -1},
+		{name: "returns plain text without key", key: "", stored: "api-key", wantSecret: "api-key", wantKeyIndex: -1},
+		{name: "fails without matching key", key: testKey, stored: encryptedWithOldKey, wantErr: true},
+		{name: "fails without key", key: "", stored: encryptedWithKey, wantErr: true},
+		{name: "fails on invalid encoding", key: testKey, stored: encryptedPrefix + "not base64!", wantErr: true},
+		{name: "fails on truncated ciphertext", key: testKey, stored: encryptedPrefix + "AAAA", wantErr: true},
+		{name: "fails on tampered ciphertext", key: testKey, stored: encryptedWithKey[:len(encryptedWithKey)-4] + "AAAA", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setEncryptionKeys(t, tt.key, tt.oldKeys)
+			secret, keyIndex, err := decryptSecret(tt.stored)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %q", secret)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if secret != tt.wantSecret || keyIndex != tt.wantKeyIndex {
+				t.Fatalf("got %q with key %d, want %q with key %d", secret, keyIndex, tt.wantSecret, tt.wantKeyIndex)
+			}
+		})
+	}
+}
+
+func TestReencryptSecret(t *testing.T) {
+	encryptedWithKey := encryptWith(t, testKey, "api-key")
+	encryptedWithOldKey := encryptWith(t, testOldKey, "api-key")
+
+	tests := []struct {
+		name        string
+		stored      null.String
+		wantChanged bool
+	}{
+		{name: "keeps secret encrypted with current key", stored: null.StringFrom(encryptedWithKey), wantChanged: false},
+		{name: "re-encrypts secret encrypted with old key", stored: null.StringFrom(encryptedWithOldKey), wantChanged: true},
+		{name: "encrypts plain text secret", stored: null.StringFrom("api-key"), wantChanged: true},
+		{name: "keeps missing secret", stored: null.String{}, wantChanged: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setEncryptionKeys(t, testKey, testOldKey)
+			reencrypted, err := reencryptSecret(tt.stored)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if changed := reencrypted != tt.stored; changed != tt.wantChanged {
+				t.Fatalf("changed = %t, want %t", changed, tt.wantChanged)
+			}
+			if !tt.stored.Valid {
+				return
+			}
+			secret, keyIndex, err := decryptSecret(reencrypted.String)
+			if err != nil {
+				t.Fatalf("decrypting re-encrypted secret: %v", err)
+			}
+			if secret != "api-key" || keyIndex != 0 {
+				t.Fatalf("got %q with key %d, want %q with key 0", secret, keyIndex, "api-key")
+			}
+		})
+	}
+}
@@ -47,5 +47,12 @@ func InitConfiguration(connection db.Connection) error {
 		RefreshInterval: 30,
 		ProjectIDs:      &[]string{"1"},
 	})
+	_, err = InsertConfig(context.Background(), apiserver.Configuration{
+		Address:         "http://localhost:3034",
+		ApiKey:          "ikcsjhzrflwz5",
+		Enable:          common.Ptr(false),
+		RefreshInterval: 30,
+		ProjectIDs:      &[]string{"1"},
+	})
 	return err
 }
@@ -19,16 +19,30 @@ create schema if not exists kentix;
 -- Should be editable by eliona frontend.
 create table if not exists kentix.configuration
 (
-	id               bigserial primary key,
-	address          text,
-	api_key          text,
-	enable           boolean default false,
-	refresh_interval integer not null default 60,
-	request_timeout  integer not null default 120,
-	active           boolean default false,
-	project_ids      text[]
+	id                      bigserial primary key,
+	address                 text,
+	api_key                 text,
+	enable                  boolean default false,
+	refresh_interval        integer not null default 60,
+	request_timeout         integer not null default 120,
+	active                  boolean default false,
+	project_ids             text[],
+	webhook_secret          text,
+	ca_certificate          text,
+	certificate_fingerprint text,
+	trust_on_first_use      boolean default false,
+	insecure                boolean default true,
+	offline_alarm_threshold integer not null default 3
 );
 
+-- Columns added after the first release
+alter table kentix.configuration add column if not exists webhook_secret text;
+alter table kentix.configuration add column if not exists ca_certificate text;
+alter table kentix.configuration add column if not exists certificate_fingerprint text;
+alter table kentix.configuration add column if not exists trust_on_first_use boolean default false;
+alter table kentix.configuration add column if not exists insecure boolean default true;
+alter table kentix.configuration add column if not exists offline_alarm_threshold integer not null default 3;
+
 -- Sensor corresponds to one asset in Eliona
 -- Should be read-only by eliona frontend.
 create table if not exists kentix.sensor
@@ -40,5 +54,73 @@ create table if not exists kentix.sensor
 	primary key (configuration_id, project_id, serial_number)
 );
 
+-- Access log cursor remembers the last access event imported from an Access Manager
+-- Should be read-only by eliona frontend.
+create table if not exists kentix.access_log_cursor
+(
+	configuration_id bigint primary key references kentix.configuration(id) on delete cascade,
+	last_event_id    bigint not null default 0
+);
+
+-- Webhook remembers the webhook registered by the app on the Kentix device
+-- Should be read-only by eliona frontend.
+create table if not exists kentix.webhook
+(
+	configuration_id bigint primary key references kentix.configuration(id) on delete cascade,
+	webhook_id       integer not null,
+	url              text not null,
+	secret_hash      text
+);
+
+alter table kentix.webhook add column if not exists secret_hash text;
+
+-- Configuration status keeps the outcome of the latest polls of a configuration
+-- Should be read-only by eliona frontend.
+create table if not exists kentix.configuration_status
+(
+	configuration_id     bigint primary key references kentix.configuration(id) on delete cascade,
+	device_serial        text,
+	last_success_at      timestamptz,
+	last_error           text,
+	last_error_at        timestamptz,
+	consecutive_failures integer not null default 0,
+	poll_duration_ms     integer not null default 0,
+	assets_updated       integer not null default 0,
+	circuit_open_until   timestamptz
+);
+
+-- Columns added after the configuration status was introduced
+alter table kentix.configuration_status add column if not exists device_serial text;
+alter table kentix.configuration_status add column if not exists circuit_open_until timestamptz;
+
+-- Poll lease assigns each configuration to the app instance polling it
+-- Should be read-only by eliona frontend.
+create table if not exists kentix.poll_lease
+(
+	configuration_id bigint primary key references kentix.configuration(id) on delete cascade,
+	owner            text not null,
+	expires_at       timestamptz not null
+);
+
+-- Slave certificate pins the certificate of a slave collected through a master with pinned certificate
+-- Should be read-only by eliona frontend.
+create table if not exists kentix.slave_certificate
+(
+	configuration_id bigint references kentix.configuration(id) on delete cascade,
+	serial_number    text,
+	fingerprint      text not null,
+	primary key (configuration_id, serial_number)
+);
+
+-- Slave status counts the polls in a row a slave collected through its master didn't answer
+-- Should be read-only by eliona frontend.
+create table if not exists kentix.slave_status
+(
+	configuration_id     bigint references kentix.configuration(id) on delete cascade,
+	serial_number        text,
+	consecutive_failures integer not null default 0,
+	primary key (configuration_id, serial_number)
+);
+
 -- Makes the new objects available for all other init steps
 commit;
@@ -0,0 +1,150 @@
+//  This file is part of the eliona project.
+//  Copyright © 2023 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package eliona
+
+import (
+	"context"
+	"fmt"
+	"kentix/kentix"
+	"sync"
+
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+	"github.com/eliona-smart-building-assistant/go-eliona/client"
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// alarmRule describes an Eliona alarm which is raised as soon as a digital status attribute
+// switches to 1, i.e. on the rising edge of the state reported by the Kentix device. Rules with
+// a high limit are raised as soon as the status attribute exceeds the limit instead.
+type alarmRule struct {
+	attribute string
+	priority  api.AlarmPriority
+	message   map[string]interface{}
+	high      *float64
+}
+
+// digitalAlarmRules converts the alarm rules declared by a driver.
+func digitalAlarmRules(rules []kentix.AlarmRule) []alarmRule {
+	var converted []alarmRule
+	for _, rule := range rules {
+		message := make(map[string]interface{}, len(rule.Message))
+		for language, text := range rule.Message {
+			message[language] = text
+		}
+		converted = append(converted, alarmRule{
+			attribute: rule.Attribute,
+			priority:  rule.Priority,
+			message:   message,
+		})
+	}
+	return converted
+}
+
+// offlineAlarmRule raises an alarm once the device didn't answer the given number of polls in a row.
+func offlineAlarmRule(threshold int32) alarmRule {
+	return alarmRule{
+		attribute: "failed_polls",
+		priority:  api.ALARM_PRIORITY_HEIGHT,
+		message: map[string]interface{}{
+			"de": "Gerät antwortet nicht",
+			"en": "Device not responding",
+		},
+		// Raised if greater than the limit, so the limit is one below the threshold.
+		high: common.Ptr(float64(threshold - 1)),
+	}
+}
+
+// ensuredAlarmRules remembers the alarm rules already ensured by this app instance, so that Eliona
+// is asked for existing rules only once per asset and attribute, unless the limit of the rule changes.
+var ensuredAlarmRules sync.Map
+
+func ensureAlarmRules(ctx context.Context, assetId int32, rules []alarmRule) error {
+	var existing []api.AlarmRule
+	for _, rule := range rules {
+		key := fmt.Sprintf("%d/%s", assetId, rule.attribute)
+		if high, ok := ensuredAlarmRules.Load(key); ok && equalLimit(high.(*float64), rule.high) {
+			continue
+		}
+		if existing == nil {
+			var err error
+			existing, _, err = client.NewClient().AlarmRulesAPI.
+				GetAlarmRules(client.AuthenticationContextWrap(ctx)).
+				Execute()
+			if err != nil {
+				return fmt.Errorf("fetching alarm rules: %v", err)
+			}
+		}
+		existingRule := findAlarmRule(existing, assetId, rule.attribute)
+		if existingRule == nil {
+			if err := createAlarmRule(ctx, assetId, rule); err != nil {
+				return fmt.Errorf("creating alarm rule for %s: %v", rule.attribute, err)
+			}
+			log.Debug("eliona", "Created alarm rule for asset %d and attribute %s.", assetId, rule.attribute)
+		} else if !equalLimit(existingRule.High.Get(), rule.high) {
+			if err := updateAlarmRuleLimit(ctx, *existingRule, rule); err != nil {
+				return fmt.Errorf("updating alarm rule for %s: %v", rule.attribute, err)
+			}
+			log.Debug("eliona", "Updated alarm rule for asset %d and attribute %s.", assetId, rule.attribute)
+		}
+		ensuredAlarmRules.Store(key, rule.high)
+	}
+	return nil
+}
+
+func findAlarmRule(rules []api.AlarmRule, assetId int32, attribute string) *api.AlarmRule {
+	for i, rule := range rules {
+		if rule.AssetId == assetId && rule.Subtype == api.SUBTYPE_STATUS && rule.Attribute == attribute {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+func equalLimit(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+func createAlarmRule(ctx context.Context, assetId int32, rule alarmRule) error {
+	alarmRule := api.NewAlarmRule(assetId, api.SUBTYPE_STATUS, rule.attribute, rule.priority)
+	alarmRule.RequiresAcknowledge = common.Ptr(true)
+	if rule.high != nil {
+		alarmRule.High = *api.NewNullableFloat64(rule.high)
+	} else {
+		alarmRule.Equal = *api.NewNullableFloat64(common.Ptr(1.0))
+	}
+	alarmRule.Message = rule.message
+	_, _, err := client.NewClient().AlarmRulesAPI.
+		PostAlarmRule(client.AuthenticationContextWrap(ctx)).
+		AlarmRule(*alarmRule).
+		Execute()
+	return err
+}
+
+func updateAlarmRuleLimit(ctx context.Context, alarmRule api.AlarmRule, rule alarmRule) error {
+	if !alarmRule.Id.IsSet() || alarmRule.Id.Get() == nil {
+		return fmt.Errorf("shouldn't happen: alarm rule without ID")
+	}
+	alarmRule.High = *api.NewNullableFloat64(rule.high)
+	_, _, err := client.NewClient().AlarmRulesAPI.
+		PutAlarmRuleById(client.AuthenticationContextWrap(ctx), *alarmRule.Id.Get()).
+		AlarmRule(alarmRule).
+		Execute()
+	return err
+}
@@ -23,21 +23,21 @@ import (
 	"kentix/kentix"
 
 	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
-	"github.com/eliona-smart-building-assistant/go-eliona/asset"
+	"github.com/eliona-smart-building-assistant/go-eliona/client"
 	"github.com/eliona-smart-building-assistant/go-utils/common"
 	"github.com/eliona-smart-building-assistant/go-utils/log"
 )
 
-func CreateAssetsIfNecessary(config apiserver.Configuration, spec kentix.DeviceInfo) error {
+func CreateAssetsIfNecessary(ctx context.Context, config apiserver.Configuration, spec kentix.DeviceInfo) error {
 	for _, projectId := range conf.ProjIds(config) {
-		if err := createDeviceAssetIfNecessary(config, projectId, spec); err != nil {
+		if err := createDeviceAssetIfNecessary(ctx, config, projectId, spec); err != nil {
 			return fmt.Errorf("creating assets for device %s: %v", spec.Serial, err)
 		}
 	}
 	return nil
 }
 
-func createDeviceAssetIfNecessary(config apiserver.Configuration, projectId string, spec kentix.DeviceInfo) error {
+func createDeviceAssetIfNecessary(ctx context.Context, config apiserver.Configuration, projectId string, spec kentix.DeviceInfo) error {
 	assetData := assetData{
 		config:        config,
 		projectId:     projectId,
@@ -47,35 +47,32 @@ func createDeviceAssetIfNecessary(config apiserver.Configuration, projectId stri
 		name:          fmt.Sprintf("%s (%s)", spec.Name, spec.IPAddress),
 		description:   fmt.Sprintf("%s (%s)", spec.Name, spec.Serial),
 	}
-	return createAssetIfNecessary(assetData)
+	return createAssetIfNecessary(ctx, assetData)
 }
 
-func CreateDoorlockAssetsIfNecessary(config apiserver.Configuration, spec kentix.DoorLock, parentDeviceSerial string) error {
+// CreateSlaveAssetsIfNecessary creates the asset of a slave device as child of its master.
+func CreateSlaveAssetsIfNecessary(ctx context.Context, config apiserver.Configuration, spec kentix.DeviceInfo, masterSerial string) error {
 	for _, projectId := range conf.ProjIds(config) {
-		parentAssetID, err := conf.GetAssetId(context.Background(), config, projectId, parentDeviceSerial)
+		parentAssetID, err := conf.GetAssetId(ctx, config, projectId, masterSerial)
 		if err != nil {
-			return fmt.Errorf("getting parent asset ID: %v", err)
+			return fmt.Errorf("getting master asset ID: %v", err)
 		}
-		if err := createDoorlockAssetIfNecessary(config, projectId, parentAssetID, spec); err != nil {
-			return fmt.Errorf("creating assets for device %s: %v", spec.Serial, err)
+		assetData := assetData{
+			config:        config,
+			projectId:     projectId,
+			parentAssetId: parentAssetID,
+			identifier:    spec.Serial,
+			assetType:     spec.AssetType,
+			name:          fmt.Sprintf("%s (%s)", spec.Name, spec.IPAddress),
+			description:   fmt.Sprintf("%s (%s)", spec.Name, spec.Serial),
+		}
+		if err := createAssetIfNecessary(ctx, assetData); err != nil {
+			return fmt.Errorf("creating assets for slave %s: %v", spec.Serial, err)
 		}
 	}
 	return nil
 }
 
-func createDoorlockAssetIfNecessary(config apiserver.Configuration, projectId string, parentAssetId *int32, spec kentix.DoorLock) error {
-	assetData := assetData{
-		config:        config,
-		projectId:     projectId,
-		parentAssetId: parentAssetId,
-		identifier:    spec.Serial,
-		assetType:     kentix.DoorlockAssetType,
-		name:          fmt.Sprintf("%s (%s)", spec.Name, spec.Address),
-		description:   fmt.Sprintf("%s (%s)", spec.Name, spec.Serial),
-	}
-	return createAssetIfNecessary(assetData)
-}
-
 type assetData struct {
 	config        apiserver.Configuration
 	projectId     string
@@ -86,9 +83,9 @@ type assetData struct {
 	description   string
 }
 
-func createAssetIfNecessary(d assetData) error {
+func createAssetIfNecessary(ctx context.Context, d assetData) error {
 	// Get known asset id from configuration
-	assetID, err := conf.GetAssetId(context.Background(), d.config, d.projectId, d.identifier)
+	assetID, err := conf.GetAssetId(ctx, d.config, d.projectId, d.identifier)
 	if err != nil {
 		return fmt.Errorf("finding asset ID: %v", err)
 	}
@@ -96,23 +93,27 @@ func createAssetIfNecessary(d assetData) error {
 		return nil
 	}
 
-	newId, err := asset.UpsertAsset(api.Asset{
-		ProjectId:               d.projectId,
-		GlobalAssetIdentifier:   d.identifier,
-		Name:                    *api.NewNullableString(common.Ptr(d.name)),
-		AssetType:               d.assetType,
-		Description:             *api.NewNullableString(common.Ptr(d.description)),
-		ParentFunctionalAssetId: *api.NewNullableInt32(d.parentAssetId),
-	})
+	upserted, _, err := client.NewClient().AssetsAPI.
+		PutAsset(client.AuthenticationContextWrap(ctx)).
+		Asset(api.Asset{
+			ProjectId:               d.projectId,
+			GlobalAssetIdentifier:   d.identifier,
+			Name:                    *api.NewNullableString(common.Ptr(d.name)),
+			AssetType:               d.assetType,
+			Description:             *api.NewNullableString(common.Ptr(d.description)),
+			ParentFunctionalAssetId: *api.NewNullableInt32(d.parentAssetId),
+		}).
+		Execute()
 	if err != nil {
 		return fmt.Errorf("upserting asset into Eliona: %v", err)
 	}
+	newId := upserted.Id.Get()
 	if newId == nil {
 		return fmt.Errorf("cannot create asset %s", d.name)
 	}
 
 	// Remember the asset id for further usage
-	if err := conf.InsertSensor(context.Background(), d.config, d.projectId, d.identifier, *newId); err != nil {
+	if err := conf.InsertSensor(ctx, d.config, d.projectId, d.identifier, *newId); err != nil {
 		return fmt.Errorf("inserting asset to config db: %v", err)
 	}
 
@@ -16,6 +16,7 @@
 package eliona
 
 import (
+	"context"
 	"fmt"
 	"kentix/kentix"
 
@@ -24,14 +25,14 @@ import (
 	"github.com/eliona-smart-building-assistant/go-utils/common"
 )
 
-func KentixDevicesDashboard(projectId string) (api.Dashboard, error) {
+func KentixDevicesDashboard(ctx context.Context, projectId string) (api.Dashboard, error) {
 	dashboard := api.Dashboard{}
 	dashboard.Name = "Kentix devices"
 	dashboard.ProjectId = projectId
 	dashboard.Widgets = []api.Widget{}
 
 	multiSensors, _, err := client.NewClient().AssetsAPI.
-		GetAssets(client.AuthenticationContext()).
+		GetAssets(client.AuthenticationContextWrap(ctx)).
 		AssetTypeName(kentix.MultiSensorAssetType).
 		ProjectId(projectId).
 		Execute()
@@ -105,7 +106,7 @@ func KentixDevicesDashboard(projectId string) (api.Dashboard, error) {
 	}
 
 	doorlocks, _, err := client.NewClient().AssetsAPI.
-		GetAssets(client.AuthenticationContext()).
+		GetAssets(client.AuthenticationContextWrap(ctx)).
 		AssetTypeName(kentix.DoorlockAssetType).
 		ProjectId(projectId).
 		Execute()
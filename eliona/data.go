@@ -17,21 +17,26 @@ package eliona
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"kentix/apiserver"
 	"kentix/conf"
 	"kentix/kentix"
+	"net/http"
 	"time"
 
 	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
-	"github.com/eliona-smart-building-assistant/go-eliona/asset"
+	"github.com/eliona-smart-building-assistant/go-eliona/client"
 	"github.com/eliona-smart-building-assistant/go-utils/common"
 	"github.com/eliona-smart-building-assistant/go-utils/log"
 )
 
-func UpsertDeviceInfo(config apiserver.Configuration, device kentix.DeviceInfo) error {
+// ErrAssetNotFound is returned if data is pushed for a device which has no asset yet.
+var ErrAssetNotFound = errors.New("asset not found")
+
+func UpsertDeviceInfo(ctx context.Context, config apiserver.Configuration, device kentix.DeviceInfo) error {
 	for _, projectId := range conf.ProjIds(config) {
-		err := upsertDeviceInfo(config, projectId, device)
+		err := upsertDeviceInfo(ctx, config, projectId, device)
 		if err != nil {
 			return err
 		}
@@ -45,9 +50,9 @@ type deviceInfoPayload struct {
 	FirmwareVersion string `json:"firmware_version"`
 }
 
-func upsertDeviceInfo(config apiserver.Configuration, projectId string, device kentix.DeviceInfo) error {
+func upsertDeviceInfo(ctx context.Context, config apiserver.Configuration, projectId string, device kentix.DeviceInfo) error {
 	log.Debug("Eliona", "Upsert data for device: config %d and device '%s'", config.Id, device.Serial)
-	assetId, err := conf.GetAssetId(context.Background(), config, projectId, device.Serial)
+	assetId, err := conf.GetAssetId(ctx, config, projectId, device.Serial)
 	if err != nil {
 		return err
 	}
@@ -55,6 +60,7 @@ func upsertDeviceInfo(config apiserver.Configuration, projectId string, device k
 		return fmt.Errorf("unable to find asset ID")
 	}
 	return upsertData(
+		ctx,
 		api.SUBTYPE_INFO,
 		*assetId,
 		deviceInfoPayload{
@@ -65,106 +71,200 @@ func upsertDeviceInfo(config apiserver.Configuration, projectId string, device k
 	)
 }
 
-func UpsertDoorlockData(config apiserver.Configuration, doorlock kentix.DoorLock) error {
-	for _, projectId := range conf.ProjIds(config) {
-		err := upsertDoorlockData(config, projectId, doorlock)
-		if err != nil {
-			return err
+// UpsertAssets creates the assets collected by a driver if necessary and writes their data. It
+// returns the number of assets written, also if writing fails halfway.
+func UpsertAssets(ctx context.Context, config apiserver.Configuration, assets []kentix.Asset) (int, error) {
+	for i, asset := range assets {
+		for _, projectId := range conf.ProjIds(config) {
+			if err := upsertAsset(ctx, config, projectId, asset); err != nil {
+				return i, fmt.Errorf("upserting asset %s: %v", asset.Identifier, err)
+			}
 		}
 	}
-	return nil
-}
-
-type doorlockDataPayload struct {
-	SerialNumber string `json:"serial_number"`
-	Name         string `json:"name"`
-	DoorContact  int    `json:"door_contact"`
+	return len(assets), nil
 }
 
-func upsertDoorlockData(config apiserver.Configuration, projectId string, doorlock kentix.DoorLock) error {
-	log.Debug("Eliona", "Upsert data for doorlock: config %d and doorlock '%s'", config.Id, doorlock.Serial)
-	assetId, err := conf.GetAssetId(context.Background(), config, projectId, doorlock.Serial)
+func upsertAsset(ctx context.Context, config apiserver.Configuration, projectId string, asset kentix.Asset) error {
+	log.Debug("Eliona", "Upserting data for asset: config %d and asset '%s'", config.Id, asset.Identifier)
+	var parentAssetId *int32
+	if asset.Parent != "" {
+		var err error
+		parentAssetId, err = conf.GetAssetId(ctx, config, projectId, asset.Parent)
+		if err != nil {
+			return fmt.Errorf("getting parent asset ID: %v", err)
+		}
+	}
+	if err := createAssetIfNecessary(ctx, assetData{
+		config:        config,
+		projectId:     projectId,
+		parentAssetId: parentAssetId,
+		identifier:    asset.Identifier,
+		assetType:     asset.AssetType,
+		name:          asset.Name,
+		description:   asset.Description,
+	}); err != nil {
+		return fmt.Errorf("creating asset: %v", err)
+	}
+	assetId, err := conf.GetAssetId(ctx, config, projectId, asset.Identifier)
 	if err != nil {
 		return err
 	}
 	if assetId == nil {
 		return fmt.Errorf("unable to find asset ID")
 	}
-	return upsertData(
-		api.SUBTYPE_INFO,
-		*assetId,
-		doorlockDataPayload{
-			SerialNumber: doorlock.Serial,
-			Name:         doorlock.Name,
-			DoorContact:  doorlock.DoorContact,
-		},
-	)
+	if len(asset.AlarmRules) > 0 {
+		if err := ensureAlarmRules(ctx, *assetId, digitalAlarmRules(asset.AlarmRules)); err != nil {
+			return fmt.Errorf("ensuring alarm rules: %v", err)
+		}
+	}
+	return upsertAssetData(ctx, *assetId, asset.Data)
+}
+
+// UpsertAssetData writes data to an existing asset, e.g. when pushed by a webhook.
+func UpsertAssetData(ctx context.Context, config apiserver.Configuration, identifier string, data []kentix.Data) error {
+	for _, projectId := range conf.ProjIds(config) {
+		assetId, err := conf.GetAssetId(ctx, config, projectId, identifier)
+		if err != nil {
+			return err
+		}
+		if assetId == nil {
+			return ErrAssetNotFound
+		}
+		if err := upsertAssetData(ctx, *assetId, data); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func UpsertMultiSensorData(config apiserver.Configuration, sensorData kentix.SensorData) error {
-	sensors, err := conf.GetConfigSensors(context.Background(), config)
+func upsertAssetData(ctx context.Context, assetId int32, data []kentix.Data) error {
+	// Checked once for all data, as the access log writes many events at once.
+	exists, err := existAsset(ctx, assetId)
 	if err != nil {
-		return fmt.Errorf("getting config sensors: %v", err)
+		return fmt.Errorf("checking asset %d: %v", assetId, err)
 	}
-	for _, projectId := range conf.ProjIds(config) {
-		for _, sensor := range sensors {
-			if err := upsertMultiSensorData(sensor, projectId, sensorData); err != nil {
-				return fmt.Errorf("upserting MultiSensor data: %v", err)
-			}
+	if !exists {
+		return nil
+	}
+	for _, d := range data {
+		timestamp := d.Timestamp
+		if timestamp.IsZero() {
+			timestamp = time.Now()
+		}
+		if err := putData(ctx, d.Subtype, assetId, timestamp, d.Payload); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
-type sensorDataPayload struct {
-	Temperature    string `json:"temperature"`
-	Humidity       string `json:"humidity"`
-	DewPoint       string `json:"dew_point"`
-	AirPressure    string `json:"air_pressure"`
-	AirQuality     string `json:"air_quality"`
-	CO2            string `json:"co2"`
-	CO             string `json:"co"`
-	Heat           string `json:"heat"`
-	ThermalImaging string `json:"ti"`
-	Motion         string `json:"motion"`
-	Vibration      string `json:"vibration"`
-	PeopleCount    string `json:"people_count"`
+type doorlockOutputPayload struct {
+	OpenCommand int `json:"open_command"`
 }
 
-func upsertMultiSensorData(sensor apiserver.Sensor, projectId string, sensorData kentix.SensorData) error {
-	log.Debug("Eliona", "Upserting data for MultiSensor: sensor %s and MultiSensor '%s'", sensor.SerialNumber, sensorData.Name)
+type doorlockOpenResultPayload struct {
+	OpenResult string `json:"open_result"`
+}
 
+// UpsertDoorlockOpenResult writes the result of a remote opening back to the doorlock asset and
+// resets the open command, so that the door can be opened again.
+func UpsertDoorlockOpenResult(ctx context.Context, assetId int32, openErr error) error {
+	result := "opened"
+	if openErr != nil {
+		result = fmt.Sprintf("failed: %v", openErr)
+	}
+	if err := upsertData(
+		ctx,
+		api.SUBTYPE_STATUS,
+		assetId,
+		doorlockOpenResultPayload{
+			OpenResult: result,
+		},
+	); err != nil {
+		return err
+	}
 	return upsertData(
-		api.SUBTYPE_INPUT,
-		*sensor.AssetID,
-		sensorDataPayload{
-			Temperature:    sensorData.Temperature.Value,
-			Humidity:       sensorData.Humidity.Value,
-			DewPoint:       sensorData.Dewpoint.Value,
-			AirPressure:    sensorData.AirPressure.Value,
-			AirQuality:     sensorData.AirQuality.Value,
-			CO2:            sensorData.CO2.Value,
-			CO:             sensorData.CO.Value,
-			Heat:           sensorData.Heat.Value,
-			ThermalImaging: sensorData.TI.Value,
-			Motion:         sensorData.Motion.Value,
-			Vibration:      sensorData.Vibration.Value,
-			PeopleCount:    sensorData.PeopleCount.Value,
+		ctx,
+		api.SUBTYPE_OUTPUT,
+		assetId,
+		doorlockOutputPayload{
+			OpenCommand: 0,
 		},
 	)
 }
 
-//
+type connectivityPayload struct {
+	Online      int   `json:"online"`
+	FailedPolls int32 `json:"failed_polls"`
+}
+
+// UpsertConnectivity writes whether the device answered the last poll and how many polls in a row it
+// didn't answer. An alarm is raised once these failed polls reach the alarm threshold.
+func UpsertConnectivity(ctx context.Context, config apiserver.Configuration, deviceSerial string, failedPolls int32, alarmThreshold int32) error {
+	for _, projectId := range conf.ProjIds(config) {
+		assetId, err := conf.GetAssetId(ctx, config, projectId, deviceSerial)
+		if err != nil {
+			return err
+		}
+		if assetId == nil {
+			// The asset is created once the device answered for the first time.
+			continue
+		}
+		if err := ensureAlarmRules(ctx, *assetId, []alarmRule{offlineAlarmRule(alarmThreshold)}); err != nil {
+			return fmt.Errorf("ensuring offline alarm rule: %v", err)
+		}
+		if err := upsertData(
+			ctx,
+			api.SUBTYPE_STATUS,
+			*assetId,
+			connectivityPayload{
+				Online:      kentix.BoolToInt(failedPolls == 0),
+				FailedPolls: failedPolls,
+			},
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func upsertData(ctx context.Context, subtype api.DataSubtype, assetId int32, payload any) error {
+	exists, err := existAsset(ctx, assetId)
+	if err != nil {
+		return fmt.Errorf("checking asset %d: %v", assetId, err)
+	}
+	if !exists {
+		return nil
+	}
+	return putData(ctx, subtype, assetId, time.Now(), payload)
+}
 
-func upsertData(subtype api.DataSubtype, assetId int32, payload any) error {
+func putData(ctx context.Context, subtype api.DataSubtype, assetId int32, timestamp time.Time, payload any) error {
 	var statusData api.Data
 	statusData.Subtype = subtype
-	now := time.Now()
-	statusData.Timestamp = *api.NewNullableTime(&now)
+	statusData.Timestamp = *api.NewNullableTime(&timestamp)
 	statusData.AssetId = assetId
 	statusData.Data = common.StructToMap(payload)
-	if err := asset.UpsertDataIfAssetExists(statusData); err != nil {
+	statusData.ClientReference = *api.NewNullableString(common.Ptr(ClientReference))
+	if _, err := client.NewClient().DataAPI.
+		PutData(client.AuthenticationContextWrap(ctx)).
+		Data(statusData).
+		Execute(); err != nil {
 		return fmt.Errorf("upserting data: %v", err)
 	}
 	return nil
 }
+
+// existAsset tells whether the asset still exists in Eliona, e.g. it might have been deleted by a user.
+func existAsset(ctx context.Context, assetId int32) (bool, error) {
+	_, response, err := client.NewClient().AssetsAPI.
+		GetAssetById(client.AuthenticationContextWrap(ctx), assetId).
+		Execute()
+	if response != nil && response.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
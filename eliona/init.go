@@ -17,6 +17,7 @@ package eliona
 
 import (
 	"fmt"
+	"kentix/kentix"
 
 	"github.com/eliona-smart-building-assistant/go-eliona/asset"
 	"github.com/eliona-smart-building-assistant/go-utils/db"
@@ -24,17 +25,17 @@ import (
 
 // InitEliona initializes the app in eliona
 func InitEliona(connection db.Connection) error {
-	if err := asset.InitAssetTypeFile("eliona/asset-type-alarm-manager.json")(connection); err != nil {
-		return fmt.Errorf("init alarm manager asset type: %v", err)
-	}
-	if err := asset.InitAssetTypeFile("eliona/asset-type-access-manager.json")(connection); err != nil {
-		return fmt.Errorf("init access manager asset type: %v", err)
-	}
-	if err := asset.InitAssetTypeFile("eliona/asset-type-doorlock.json")(connection); err != nil {
-		return fmt.Errorf("init doorlock asset type: %v", err)
-	}
-	if err := asset.InitAssetTypeFile("eliona/asset-type-multi-sensor.json")(connection); err != nil {
-		return fmt.Errorf("init multi sensor asset type: %v", err)
+	initialized := make(map[string]bool)
+	for _, driver := range kentix.Drivers() {
+		for _, file := range driver.AssetTypeFiles() {
+			if initialized[file] {
+				continue
+			}
+			if err := asset.InitAssetTypeFile(file)(connection); err != nil {
+				return fmt.Errorf("init asset type %s: %v", file, err)
+			}
+			initialized[file] = true
+		}
 	}
 	return nil
 }
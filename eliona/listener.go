@@ -0,0 +1,46 @@
+//  This file is part of the eliona project.
+//  Copyright © 2023 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package eliona
+
+import (
+	"time"
+
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+	"github.com/eliona-smart-building-assistant/go-eliona/client"
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+	"github.com/eliona-smart-building-assistant/go-utils/http"
+	"github.com/gorilla/websocket"
+)
+
+// ClientReference marks data written by this app, so that the app can ignore its own output
+// changes echoed by the data listener.
+const ClientReference = "kentix-app"
+
+// ListenForOutputChanges returns a channel with all changes of output attributes in Eliona. The
+// connection to Eliona is reestablished if it breaks.
+func ListenForOutputChanges() chan api.Data {
+	outputs := make(chan api.Data)
+	go http.ListenWebSocketWithReconnectAlways(newOutputWebSocket, 5*time.Second, outputs)
+	return outputs
+}
+
+func newOutputWebSocket() (*websocket.Conn, error) {
+	return http.NewWebSocketConnectionWithApiKey(
+		client.ApiEndpointString()+"/data-listener?dataSubtype="+string(api.SUBTYPE_OUTPUT),
+		"X-API-Key",
+		common.Getenv("API_TOKEN", ""),
+	)
+}
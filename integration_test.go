@@ -33,14 +33,19 @@ func TestApp(t *testing.T) {
 func assetTypes(t *testing.T) {
 	t.Parallel()
 
+	assert.AssetTypeExists(t, "kentix_access_log", []string{"user", "medium", "door", "doorlock_serial", "result"})
 	assert.AssetTypeExists(t, "kentix_access_manager", []string{"firmware_version", "mac_address", "ip_address"})
 	assert.AssetTypeExists(t, "kentix_alarm_manager", []string{"firmware_version", "mac_address", "ip_address"})
-	assert.AssetTypeExists(t, "kentix_doorlock", []string{"door_contact", "name", "serial_number"})
-	assert.AssetTypeExists(t, "kentix_multi_sensor", []string{"people_count", "vibration", "motion", "ti", "ip_address"})
+	assert.AssetTypeExists(t, "kentix_alarm_sensor", []string{"serial_number", "name", "sensor_type", "connection", "battery", "alarm"})
+	assert.AssetTypeExists(t, "kentix_alarm_zone", []string{"name", "armed", "alarm", "arm_command"})
+	assert.AssetTypeExists(t, "kentix_digital_input", []string{"alarm", "scaled_value", "value", "unit", "name"})
+	assert.AssetTypeExists(t, "kentix_doorlock", []string{"battery", "door_contact", "lock_state", "name", "open_command", "open_result", "serial_number"})
+	assert.AssetTypeExists(t, "kentix_multi_sensor", []string{"people_count", "vibration", "motion", "ti", "ip_address", "occupancy_count", "occupancy_max", "occupancy_percentage", "alarm", "co2_alarm", "co2_warning", "power_sabotage", "connection_sabotage", "internal_sabotage"})
+	assert.AssetTypeExists(t, "kentix_smart_x_scan", []string{"temperature", "humidity", "co", "ti", "ip_address"})
 }
 
 func schema(t *testing.T) {
 	t.Parallel()
 
-	assert.SchemaExists(t, "kentix", []string{"configuration", "sensor"})
+	assert.SchemaExists(t, "kentix", []string{"configuration", "sensor", "access_log_cursor", "webhook", "configuration_status", "poll_lease", "slave_certificate", "slave_status"})
 }
@@ -0,0 +1,192 @@
+//  This file is part of the eliona project.
+//  Copyright © 2023 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package kentix
+
+import (
+	"context"
+	"fmt"
+	"kentix/apiserver"
+	"time"
+
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// accessManagerDriver supports the Access Manager with its doorlocks and access log.
+type accessManagerDriver struct{}
+
+func init() {
+	Register(accessManagerDriver{})
+}
+
+func (accessManagerDriver) TypeIds() []int {
+	return []int{1}
+}
+
+func (accessManagerDriver) AssetType() string {
+	return AccessPointAssetType
+}
+
+func (accessManagerDriver) AssetTypeFiles() []string {
+	return []string{
+		"eliona/asset-type-access-manager.json",
+		"eliona/asset-type-doorlock.json",
+		"eliona/asset-type-access-log.json",
+	}
+}
+
+func (accessManagerDriver) Readers() []Reader {
+	return []Reader{
+		{"doorlocks", func(ctx context.Context, config apiserver.Configuration) error {
+			_, err := GetAccessPointReadings(ctx, config)
+			return err
+		}},
+		{"access log", func(ctx context.Context, config apiserver.Configuration) error {
+			// One page is enough to check the access log, instead of the whole access history.
+			_, err := GetAccessEvents(ctx, config, 0, 1)
+			return err
+		}},
+	}
+}
+
+func (accessManagerDriver) Collect(ctx context.Context, config apiserver.Configuration, device DeviceInfo, state PollState) ([]Asset, error) {
+	var assets []Asset
+	doorlocks, err := GetAccessPointReadings(ctx, config)
+	if err != nil {
+		return assets, fmt.Errorf("getting AccessPoint readings: %v", err)
+	}
+	values, errs, err := GetDoorlockValues(ctx, config, doorlocks)
+	if err != nil {
+		return assets, fmt.Errorf("getting doorlock values: %v", err)
+	}
+	for i, doorlock := range doorlocks {
+		data := []Data{{
+			Subtype: api.SUBTYPE_INFO,
+			Payload: doorlockInfoPayload{
+				SerialNumber: doorlock.Serial,
+				Name:         doorlock.Name,
+			},
+		}}
+		if errs[i] != nil {
+			// The asset and info of the doorlock are still updated from the list of doorlocks.
+			log.Error("kentix", "getting values of doorlock '%s': %v", doorlock.Serial, errs[i])
+		} else {
+			data = append(data, DoorlockValuesData(*values[i])...)
+		}
+		assets = append(assets, Asset{
+			Identifier:  doorlock.Serial,
+			AssetType:   DoorlockAssetType,
+			Name:        fmt.Sprintf("%s (%s)", doorlock.Name, doorlock.Address),
+			Description: fmt.Sprintf("%s (%s)", doorlock.Name, doorlock.Serial),
+			Parent:      device.Serial,
+			Data:        data,
+		})
+	}
+
+	// The master keeps the access log of all its slaves.
+	if device.MasterSlave.IsSlave {
+		return assets, nil
+	}
+	accessLog, err := accessLogAsset(ctx, config, device, state.AccessLogCursor)
+	if err != nil {
+		return assets, fmt.Errorf("collecting access events: %v", err)
+	}
+	if accessLog != nil {
+		assets = append(assets, *accessLog)
+	}
+	return assets, nil
+}
+
+type doorlockInfoPayload struct {
+	SerialNumber string `json:"serial_number"`
+	Name         string `json:"name"`
+}
+
+type doorlockDataPayload struct {
+	DoorContact *int `json:"door_contact,omitempty"`
+	LockState   *int `json:"lock_state,omitempty"`
+	Battery     *int `json:"battery,omitempty"`
+}
+
+// DoorlockValuesData maps the live values of a doorlock, which are also pushed by webhooks.
+func DoorlockValuesData(values DoorlockValues) []Data {
+	return []Data{{
+		Subtype: api.SUBTYPE_INPUT,
+		Payload: doorlockDataPayload{
+			DoorContact: values.DoorContact,
+			LockState:   values.LockState,
+			Battery:     values.Battery,
+		},
+	}}
+}
+
+type accessEventPayload struct {
+	User           string `json:"user"`
+	Medium         string `json:"medium"`
+	Door           string `json:"door"`
+	DoorlockSerial string `json:"doorlock_serial"`
+	Result         string `json:"result"`
+}
+
+// maxAccessEventsPerPoll limits the access events imported in one poll, so that importing the
+// history of a busy Access Manager is spread over several polls instead of blocking a worker.
+const maxAccessEventsPerPoll = 100
+
+// accessLogAsset returns the oldest access events after the cursor, or nil if there are none. Each
+// event keeps its own timestamp, so that the trend of the access log asset contains the complete
+// access history. The asset carries the new cursor, which is only to be stored once the events are
+// written, so no event is lost if writing fails.
+func accessLogAsset(ctx context.Context, config apiserver.Configuration, device DeviceInfo, lastEventId int64) (*Asset, error) {
+	events, err := GetAccessEvents(ctx, config, lastEventId, maxAccessEventsPerPoll)
+	if err != nil {
+		return nil, fmt.Errorf("getting access events: %v", err)
+	}
+	if len(events) == 0 {
+		return nil, nil
+	}
+	if len(events) > maxAccessEventsPerPoll {
+		events = events[:maxAccessEventsPerPoll]
+	}
+	var data []Data
+	for _, event := range events {
+		data = append(data, Data{
+			Subtype:   api.SUBTYPE_INPUT,
+			Timestamp: time.Unix(event.Timestamp, 0),
+			Payload: accessEventPayload{
+				User:           event.User,
+				Medium:         event.Medium,
+				Door:           event.DoorlockName,
+				DoorlockSerial: event.DoorlockSerial,
+				Result:         event.Result,
+			},
+		})
+	}
+	lastEventId = events[len(events)-1].ID
+	return &Asset{
+		Identifier:      AccessLogIdentifier(device.Serial),
+		AssetType:       AccessLogAssetType,
+		Name:            "Access log",
+		Description:     fmt.Sprintf("Access log (%s)", device.Serial),
+		Parent:          device.Serial,
+		Data:            data,
+		AccessLogCursor: &lastEventId,
+	}, nil
+}
+
+// AccessLogIdentifier identifies the access log of an Access Manager.
+func AccessLogIdentifier(deviceSerial string) string {
+	return fmt.Sprintf("%s_access_log", deviceSerial)
+}
@@ -0,0 +1,181 @@
+//  This file is part of the eliona project.
+//  Copyright © 2023 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package kentix
+
+import (
+	"context"
+	"fmt"
+	"kentix/apiserver"
+
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+)
+
+// alarmManagerDriver supports the AlarmManager with its alarm zones and sensors.
+type alarmManagerDriver struct{}
+
+func init() {
+	Register(alarmManagerDriver{})
+}
+
+func (alarmManagerDriver) TypeIds() []int {
+	return []int{8}
+}
+
+func (alarmManagerDriver) AssetType() string {
+	return AlarmManagerAssetType
+}
+
+func (alarmManagerDriver) AssetTypeFiles() []string {
+	return []string{
+		"eliona/asset-type-alarm-manager.json",
+		"eliona/asset-type-alarm-zone.json",
+		"eliona/asset-type-alarm-sensor.json",
+	}
+}
+
+func (alarmManagerDriver) Readers() []Reader {
+	return []Reader{
+		{"alarm zones", func(ctx context.Context, conf apiserver.Configuration) error {
+			_, err := GetAlarmZones(ctx, conf)
+			return err
+		}},
+		{"alarm sensors", func(ctx context.Context, conf apiserver.Configuration) error {
+			_, err := GetAlarmSensors(ctx, conf)
+			return err
+		}},
+	}
+}
+
+func (alarmManagerDriver) Collect(ctx context.Context, conf apiserver.Configuration, device DeviceInfo, state PollState) ([]Asset, error) {
+	var assets []Asset
+	zones, err := GetAlarmZones(ctx, conf)
+	if err != nil {
+		return assets, fmt.Errorf("getting AlarmManager alarm zones: %v", err)
+	}
+	for _, zone := range zones {
+		assets = append(assets, Asset{
+			Identifier:  AlarmZoneIdentifier(device.Serial, zone),
+			AssetType:   AlarmZoneAssetType,
+			Name:        zone.Name,
+			Description: fmt.Sprintf("%s %d (%s)", zone.Name, zone.ID, device.Serial),
+			Parent:      device.Serial,
+			Data:        AlarmZoneData(zone),
+		})
+	}
+	sensors, err := GetAlarmSensors(ctx, conf)
+	if err != nil {
+		return assets, fmt.Errorf("getting AlarmManager sensors: %v", err)
+	}
+	for _, sensor := range sensors {
+		data := []Data{{
+			Subtype: api.SUBTYPE_INFO,
+			Payload: alarmSensorInfoPayload{
+				SerialNumber: sensor.Serial,
+				Name:         sensor.Name,
+				SensorType:   sensor.Type,
+				Connection:   sensor.Connection,
+			},
+		}}
+		assets = append(assets, Asset{
+			Identifier:  sensor.Serial,
+			AssetType:   AlarmSensorAssetType,
+			Name:        fmt.Sprintf("%s (%s)", sensor.Name, sensor.Type),
+			Description: fmt.Sprintf("%s (%s)", sensor.Name, sensor.Serial),
+			Parent:      device.Serial,
+			Data:        append(data, AlarmSensorStateData(sensor)...),
+		})
+	}
+	return assets, nil
+}
+
+// AlarmZoneIdentifier identifies an alarm zone, which has no serial number on its own, by the
+// AlarmManager it is defined on.
+func AlarmZoneIdentifier(deviceSerial string, zone AlarmZone) string {
+	return fmt.Sprintf("%s_zone_%d", deviceSerial, zone.ID)
+}
+
+type alarmZoneInfoPayload struct {
+	Name string `json:"name"`
+}
+
+type alarmZoneStatusPayload struct {
+	Armed int `json:"armed"`
+	Alarm int `json:"alarm"`
+}
+
+type alarmZoneOutputPayload struct {
+	ArmCommand int `json:"arm_command"`
+}
+
+// AlarmZoneData maps the state of an alarm zone, which is also written back after arming the zone.
+func AlarmZoneData(zone AlarmZone) []Data {
+	return []Data{
+		{
+			Subtype: api.SUBTYPE_INFO,
+			Payload: alarmZoneInfoPayload{
+				Name: zone.Name,
+			},
+		},
+		{
+			Subtype: api.SUBTYPE_STATUS,
+			Payload: alarmZoneStatusPayload{
+				Armed: BoolToInt(bool(zone.Armed)),
+				Alarm: BoolToInt(bool(zone.HasAlarm)),
+			},
+		},
+		// The output mirrors the actual state, so that the switch in Eliona shows whether the zone is armed.
+		{
+			Subtype: api.SUBTYPE_OUTPUT,
+			Payload: alarmZoneOutputPayload{
+				ArmCommand: BoolToInt(bool(zone.Armed)),
+			},
+		},
+	}
+}
+
+type alarmSensorInfoPayload struct {
+	SerialNumber string `json:"serial_number"`
+	Name         string `json:"name"`
+	SensorType   string `json:"sensor_type"`
+	Connection   string `json:"connection"`
+}
+
+type alarmSensorDataPayload struct {
+	Battery *int `json:"battery,omitempty"`
+}
+
+type alarmSensorStatusPayload struct {
+	Alarm int `json:"alarm"`
+}
+
+// AlarmSensorStateData maps the alarm state and battery of an alarm sensor, which are also pushed
+// by webhooks.
+func AlarmSensorStateData(sensor AlarmSensor) []Data {
+	return []Data{
+		{
+			Subtype: api.SUBTYPE_INPUT,
+			Payload: alarmSensorDataPayload{
+				Battery: sensor.Battery,
+			},
+		},
+		{
+			Subtype: api.SUBTYPE_STATUS,
+			Payload: alarmSensorStatusPayload{
+				Alarm: BoolToInt(sensor.State.HasAlarm),
+			},
+		},
+	}
+}
@@ -0,0 +1,106 @@
+//  This file is part of the eliona project.
+//  Copyright © 2023 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package kentix
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"kentix/apiserver"
+	nethttp "net/http"
+	"net/url"
+	"time"
+
+	"github.com/eliona-smart-building-assistant/go-utils/http"
+)
+
+// ConnectionCheck is the result of checking the connection to a Kentix device.
+type ConnectionCheck struct {
+	Reachable     bool
+	Authenticated bool
+	Info          *DeviceInfo
+	Latency       time.Duration
+	Error         string
+	Readers       []ReaderCheck
+}
+
+// ReaderCheck is the result of one of the readers used to collect data from the device.
+type ReaderCheck struct {
+	Name  string
+	Error string
+}
+
+// CheckConnection checks whether the device is reachable with the configuration, whether the API key
+// is accepted and whether the readers for the detected device type succeed.
+func CheckConnection(ctx context.Context, conf apiserver.Configuration) ConnectionCheck {
+	var check ConnectionCheck
+	url, err := url.JoinPath(conf.Address, "api/info")
+	if err != nil {
+		check.Error = fmt.Sprintf("appending endpoint to URL: %v", err)
+		return check
+	}
+	r, err := http.NewRequestWithApiKey(url, "Authorization", "Basic "+authKey(conf.ApiKey))
+	if err != nil {
+		check.Error = fmt.Sprintf("creating request to %s: %v", url, err)
+		return check
+	}
+	// The probe isn't retried, so that the latency is the one of a single request.
+	start := time.Now()
+	body, statusCode, err := doWithStatusCode(withoutRetries(ctx), conf, r)
+	check.Latency = time.Since(start)
+	if err != nil {
+		check.Error = fmt.Sprintf("requesting %s: %v", url, err)
+		return check
+	}
+	check.Reachable = true
+	if statusCode == nethttp.StatusUnauthorized || statusCode == nethttp.StatusForbidden {
+		check.Error = fmt.Sprintf("API key rejected with status code %d", statusCode)
+		return check
+	}
+	if statusCode >= 300 {
+		check.Error = fmt.Sprintf("error request code %d for request to %s", statusCode, url)
+		return check
+	}
+	check.Authenticated = true
+
+	var info infoResponse
+	if err := json.Unmarshal(body, &info); err != nil {
+		check.Error = fmt.Sprintf("unmarshaling response from %s: %v", url, err)
+		return check
+	}
+	driver, err := LookupDriver(info.Data.Type)
+	if err != nil {
+		check.Error = fmt.Sprintf("inferring asset type from %s: %v", url, err)
+		return check
+	}
+	info.Data.AssetType = driver.AssetType()
+	check.Info = &info.Data
+
+	for _, reader := range driver.Readers() {
+		readerCheck := ReaderCheck{Name: reader.Name}
+		err := reader.Read(ctx, conf)
+		if errors.Is(err, ErrNotSupported) {
+			// The device doesn't have the optional feature.
+			continue
+		}
+		if err != nil {
+			readerCheck.Error = err.Error()
+		}
+		check.Readers = append(check.Readers, readerCheck)
+	}
+	return check
+}
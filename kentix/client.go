@@ -0,0 +1,312 @@
+//  This file is part of the eliona project.
+//  Copyright © 2023 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package kentix
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"kentix/apiserver"
+	"net"
+	nethttp "net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// idleConnTimeout closes connections kept alive for reuse once they are idle for this long.
+const idleConnTimeout = 90 * time.Second
+
+// clientKey identifies the device and the settings a client is created for.
+type clientKey struct {
+	configId    int64
+	address     string
+	insecure    bool
+	ca          string
+	fingerprint string
+	timeout     time.Duration
+}
+
+// clients caches the client of each configuration and device, so that connections are reused across
+// requests instead of paying a TLS handshake each time.
+var (
+	clientsMutex sync.Mutex
+	clients      = make(map[clientKey]*nethttp.Client)
+)
+
+// httpClient returns the cached client of the configuration. Configurations which are not stored,
+// e.g. while testing or discovering devices, get a client without kept alive connections instead.
+func httpClient(conf apiserver.Configuration) (*nethttp.Client, error) {
+	if conf.Id == nil {
+		return newHttpClient(conf, false)
+	}
+	key := clientKey{
+		configId:    *conf.Id,
+		address:     conf.Address,
+		insecure:    conf.Insecure == nil || *conf.Insecure,
+		ca:          valueOf(conf.CaCertificate),
+		fingerprint: valueOf(conf.CertificateFingerprint),
+		timeout:     requestTimeout(conf),
+	}
+	clientsMutex.Lock()
+	defer clientsMutex.Unlock()
+	if client, ok := clients[key]; ok {
+		return client, nil
+	}
+	client, err := newHttpClient(conf, true)
+	if err != nil {
+		return nil, err
+	}
+	// Clients of outdated settings of the same device are replaced.
+	for cachedKey, cached := range clients {
+		if cachedKey.configId == key.configId && cachedKey.address == key.address {
+			cached.CloseIdleConnections()
+			delete(clients, cachedKey)
+		}
+	}
+	clients[key] = client
+	return client, nil
+}
+
+// ForgetClients closes the connections of the configuration, e.g. after it was changed or deleted.
+func ForgetClients(configId int64) {
+	clientsMutex.Lock()
+	defer clientsMutex.Unlock()
+	for key, client := range clients {
+		if key.configId == configId {
+			client.CloseIdleConnections()
+			delete(clients, key)
+		}
+	}
+}
+
+// newHttpClient creates a client which applies the TLS settings of the configuration.
+func newHttpClient(conf apiserver.Configuration, keepAlive bool) (*nethttp.Client, error) {
+	tlsConfig, err := newTlsConfig(conf)
+	if err != nil {
+		return nil, fmt.Errorf("creating TLS config: %v", err)
+	}
+	return &nethttp.Client{
+		Timeout: requestTimeout(conf),
+		Transport: &nethttp.Transport{
+			TLSClientConfig:   tlsConfig,
+			DisableKeepAlives: !keepAlive,
+			IdleConnTimeout:   idleConnTimeout,
+		},
+	}, nil
+}
+
+func valueOf(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// newTlsConfig creates the TLS config for a device. Configurations without explicit setting are
+// insecure to stay compatible with configurations created before TLS settings existed.
+func newTlsConfig(conf apiserver.Configuration) (*tls.Config, error) {
+	if conf.Insecure == nil || *conf.Insecure {
+		return &tls.Config{InsecureSkipVerify: true}, nil
+	}
+	if conf.CertificateFingerprint != nil && *conf.CertificateFingerprint != "" {
+		// Pinned certificates are usually self-signed, so only the fingerprint is verified.
+		pinned := normalizeFingerprint(*conf.CertificateFingerprint)
+		return &tls.Config{
+			InsecureSkipVerify: true,
+			VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+				if len(rawCerts) == 0 {
+					return fmt.Errorf("no certificate presented")
+				}
+				if fingerprint := certificateFingerprint(rawCerts[0]); fingerprint != pinned {
+					return fmt.Errorf("certificate fingerprint %s doesn't match pinned fingerprint %s", fingerprint, pinned)
+				}
+				return nil
+			},
+		}, nil
+	}
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil {
+		rootCAs = x509.NewCertPool()
+	}
+	if conf.CaCertificate != nil && *conf.CaCertificate != "" {
+		if !rootCAs.AppendCertsFromPEM([]byte(*conf.CaCertificate)) {
+			return nil, fmt.Errorf("no valid certificate found in CA certificate")
+		}
+	}
+	return &tls.Config{RootCAs: rootCAs}, nil
+}
+
+// GetCertificateFingerprint connects to the device and returns the fingerprint of the certificate
+// it presents. It is used to pin the certificate on first use.
+func GetCertificateFingerprint(ctx context.Context, conf apiserver.Configuration) (string, error) {
+	u, err := url.Parse(conf.Address)
+	if err != nil {
+		return "", fmt.Errorf("parsing address: %v", err)
+	}
+	if u.Scheme != "https" {
+		return "", fmt.Errorf("address %s doesn't use https", conf.Address)
+	}
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), "443")
+	}
+	dialer := &tls.Dialer{
+		NetDialer: &net.Dialer{Timeout: requestTimeout(conf)},
+		Config:    &tls.Config{InsecureSkipVerify: true},
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", host)
+	if err != nil {
+		return "", fmt.Errorf("connecting to %s: %v", host, err)
+	}
+	defer conn.Close()
+	certificates := conn.(*tls.Conn).ConnectionState().PeerCertificates
+	if len(certificates) == 0 {
+		return "", fmt.Errorf("no certificate presented by %s", host)
+	}
+	return certificateFingerprint(certificates[0].Raw), nil
+}
+
+func certificateFingerprint(rawCert []byte) string {
+	sum := sha256.Sum256(rawCert)
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizeFingerprint accepts fingerprints in the common notations, e.g. with colons or in upper case.
+func normalizeFingerprint(fingerprint string) string {
+	fingerprint = strings.ReplaceAll(fingerprint, ":", "")
+	fingerprint = strings.ReplaceAll(fingerprint, " ", "")
+	return strings.ToLower(fingerprint)
+}
+
+func requestTimeout(conf apiserver.Configuration) time.Duration {
+	if conf.RequestTimeout == nil {
+		return 0
+	}
+	return time.Duration(*conf.RequestTimeout) * time.Second
+}
+
+// do sends the request with the client of the configuration and returns the body of successful responses.
+func do(ctx context.Context, conf apiserver.Configuration, r *nethttp.Request) ([]byte, error) {
+	body, statusCode, err := doWithStatusCode(ctx, conf, r)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode == nethttp.StatusNotFound {
+		return nil, fmt.Errorf("error request code %d for request to %s: %w", statusCode, r.URL, ErrNotSupported)
+	}
+	if statusCode >= 300 {
+		return nil, fmt.Errorf("error request code %d for request to %s", statusCode, r.URL)
+	}
+	return body, nil
+}
+
+const (
+	// maxRetries is the number of retries of requests failing with a transient error.
+	maxRetries = 2
+	// retryBackoff is the delay before the first retry. It doubles with each further retry.
+	retryBackoff = 500 * time.Millisecond
+)
+
+type noRetriesKey struct{}
+
+// withoutRetries disables retries for the requests sent with the context, e.g. to probe addresses
+// which are expected to fail.
+func withoutRetries(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRetriesKey{}, true)
+}
+
+// doWithStatusCode sends the request and retries it with exponential backoff as long as it fails
+// with a transient error. Cancelling the context aborts the request and any pending retry.
+func doWithStatusCode(ctx context.Context, conf apiserver.Configuration, r *nethttp.Request) ([]byte, int, error) {
+	client, err := httpClient(conf)
+	if err != nil {
+		return nil, 0, err
+	}
+	r = r.WithContext(ctx)
+	backoff := retryBackoff
+	for retry := 0; ; retry++ {
+		body, statusCode, err := send(client, r)
+		if retry >= maxRetries || !isTransient(r, statusCode, err) {
+			return body, statusCode, err
+		}
+		log.Debug("kentix", "retrying request to %s in %v (status code %d, error: %v)", r.URL, backoff, statusCode, err)
+		select {
+		case <-ctx.Done():
+			return nil, 0, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func send(client *nethttp.Client, r *nethttp.Request) ([]byte, int, error) {
+	response, err := client.Do(r)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, response.StatusCode, fmt.Errorf("reading body: %w", err)
+	}
+	return body, response.StatusCode, nil
+}
+
+// isTransient tells whether a failed request is worth retrying. Only requests without side effects
+// are retried, and only if they timed out, the connection was refused or reset, or the device answered
+// with a server error or too many requests. Other errors, e.g. an untrusted certificate, a certificate
+// not matching the pinned fingerprint or an unknown host, would fail the same way again.
+func isTransient(r *nethttp.Request, statusCode int, err error) bool {
+	if r.Method != nethttp.MethodGet || r.Context().Value(noRetriesKey{}) != nil {
+		return false
+	}
+	if err != nil {
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			return true
+		}
+		return errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET)
+	}
+	return statusCode == nethttp.StatusTooManyRequests || statusCode >= nethttp.StatusInternalServerError
+}
+
+// read sends the request with the client of the configuration and unmarshals the response.
+func read[T any](ctx context.Context, conf apiserver.Configuration, r *nethttp.Request) (T, error) {
+	var value T
+	body, err := do(ctx, conf, r)
+	if err != nil {
+		return value, err
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		return value, nil
+	}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return value, fmt.Errorf("unmarshaling: %v", err)
+	}
+	return value, nil
+}
@@ -0,0 +1,78 @@
+//  This file is part of the eliona project.
+//  Copyright © 2023 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package kentix
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"net"
+	nethttp "net/http"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+)
+
+func TestIsTransient(t *testing.T) {
+	urlError := func(err error) error {
+		return &url.Error{Op: "Get", URL: "https://kentix.local/api/v1/system/info", Err: err}
+	}
+	dialError := func(err error) error {
+		return urlError(&net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", err)})
+	}
+	tests := []struct {
+		name       string
+		method     string
+		ctx        context.Context
+		statusCode int
+		err        error
+		want       bool
+	}{
+		{name: "timeout", err: urlError(context.DeadlineExceeded), want: true},
+		{name: "connection refused", err: dialError(syscall.ECONNREFUSED), want: true},
+		{name: "connection reset", err: dialError(syscall.ECONNRESET), want: true},
+		{name: "unknown host", err: urlError(&net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Err: "no such host", Name: "kentix.local", IsNotFound: true}})},
+		{name: "untrusted certificate", err: urlError(x509.UnknownAuthorityError{})},
+		{name: "pinned fingerprint mismatch", err: urlError(errors.New("certificate fingerprint 01 doesn't match pinned fingerprint 02"))},
+		{name: "too many requests", statusCode: nethttp.StatusTooManyRequests, want: true},
+		{name: "internal server error", statusCode: nethttp.StatusInternalServerError, want: true},
+		{name: "service unavailable", statusCode: nethttp.StatusServiceUnavailable, want: true},
+		{name: "unauthorized", statusCode: nethttp.StatusUnauthorized},
+		{name: "not found", statusCode: nethttp.StatusNotFound},
+		{name: "request changing the device", method: nethttp.MethodPost, statusCode: nethttp.StatusServiceUnavailable},
+		{name: "retries disabled", ctx: withoutRetries(context.Background()), err: dialError(syscall.ECONNREFUSED)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			method := tt.method
+			if method == "" {
+				method = nethttp.MethodGet
+			}
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			r, err := nethttp.NewRequestWithContext(ctx, method, "https://kentix.local/api/v1/system/info", nil)
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+			if got := isTransient(r, tt.statusCode, tt.err); got != tt.want {
+				t.Fatalf("isTransient = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+//  This file is part of the eliona project.
+//  Copyright © 2023 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package kentix
+
+import (
+	"context"
+	"fmt"
+	"kentix/apiserver"
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+const (
+	// maxDiscoveryHosts limits the size of a scanned subnet to a /20 network.
+	maxDiscoveryHosts = 4096
+	// maxConcurrentProbes limits the number of parallel requests during discovery.
+	maxConcurrentProbes = 32
+)
+
+// DiscoveredDevice is a Kentix device found during discovery.
+type DiscoveredDevice struct {
+	Address string
+	Info    DeviceInfo
+}
+
+// Discover probes all hosts of the subnet for a Kentix device. The template configuration defines
+// the API key and the request timeout used for probing.
+func Discover(ctx context.Context, cidr string, scheme string, port int, template apiserver.Configuration) ([]DiscoveredDevice, error) {
+	hosts, err := subnetHosts(cidr)
+	if err != nil {
+		return nil, err
+	}
+
+	var devices []DiscoveredDevice
+	var mutex sync.Mutex
+	semaphore := make(chan struct{}, maxConcurrentProbes)
+	var wg sync.WaitGroup
+	for _, host := range hosts {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(host string) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			address := hostAddress(scheme, host, port)
+			conf := template
+			conf.Address = address
+			// Most addresses are expected to fail, so retrying them would only slow down discovery.
+			info, err := getDeviceInfo(ctx, conf, false)
+			if err != nil {
+				log.Debug("kentix", "no Kentix device found at %s: %v", address, err)
+				return
+			}
+			mutex.Lock()
+			devices = append(devices, DiscoveredDevice{Address: address, Info: *info})
+			mutex.Unlock()
+		}(host)
+	}
+	wg.Wait()
+
+	sort.Slice(devices, func(i, j int) bool {
+		return devices[i].Address < devices[j].Address
+	})
+	return devices, nil
+}
+
+// subnetHosts lists the host addresses of an IPv4 subnet without network and broadcast address.
+func subnetHosts(cidr string) ([]string, error) {
+	ip, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("parsing CIDR '%s': %v", cidr, err)
+	}
+	if ip.To4() == nil {
+		return nil, fmt.Errorf("only IPv4 subnets are supported: %s", cidr)
+	}
+	ones, bits := network.Mask.Size()
+	size := 1 << (bits - ones)
+	if size > maxDiscoveryHosts {
+		return nil, fmt.Errorf("subnet %s too large: at most %d addresses can be scanned", cidr, maxDiscoveryHosts)
+	}
+
+	var hosts []string
+	current := network.IP.To4()
+	for i := 0; i < size; i++ {
+		// Network and broadcast addresses are no hosts, except for /31 and /32 subnets.
+		if size > 2 && (i == 0 || i == size-1) {
+			current = nextIP(current)
+			continue
+		}
+		hosts = append(hosts, current.String())
+		current = nextIP(current)
+	}
+	return hosts, nil
+}
+
+func nextIP(ip net.IP) net.IP {
+	next := make(net.IP, len(ip))
+	copy(next, ip)
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] != 0 {
+			break
+		}
+	}
+	return next
+}
+
+func hostAddress(scheme string, host string, port int) string {
+	u := url.URL{Scheme: scheme, Host: host}
+	if port != 0 {
+		u.Host = net.JoinHostPort(host, strconv.Itoa(port))
+	}
+	return u.String()
+}
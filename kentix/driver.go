@@ -0,0 +1,127 @@
+//  This file is part of the eliona project.
+//  Copyright © 2023 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package kentix
+
+import (
+	"context"
+	"fmt"
+	"kentix/apiserver"
+	"time"
+
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+)
+
+// Driver adds support for a Kentix product. Each driver registers itself with Register, so that
+// supporting a new product only needs a new driver.
+type Driver interface {
+	// TypeIds returns the device types reported in the device info which the driver handles.
+	TypeIds() []int
+	// AssetType returns the Eliona asset type of the device.
+	AssetType() string
+	// AssetTypeFiles returns the asset type definitions of the device and its child devices.
+	AssetTypeFiles() []string
+	// Readers returns the readers used to collect the data, which are checked by the connection test.
+	Readers() []Reader
+	// Collect discovers the child devices of the device and reads the data of the device and its
+	// child devices. If reading fails halfway, the assets read so far are returned with the error.
+	Collect(ctx context.Context, conf apiserver.Configuration, device DeviceInfo, state PollState) ([]Asset, error)
+}
+
+// PollState is the state kept by the app between the polls of a configuration.
+type PollState struct {
+	// AccessLogCursor is the ID of the last access event imported.
+	AccessLogCursor int64
+}
+
+// Reader is a request used to collect the data of a device.
+type Reader struct {
+	Name string
+	Read func(ctx context.Context, conf apiserver.Configuration) error
+}
+
+// Asset is the Eliona asset of a device or of one of its child devices together with its data.
+type Asset struct {
+	Identifier  string
+	AssetType   string
+	Name        string
+	Description string
+	// Parent is the identifier of the parent asset. It is empty for the asset of the device itself,
+	// which exists already.
+	Parent     string
+	Data       []Data
+	AlarmRules []AlarmRule
+	// AccessLogCursor is the ID of the last access event in the data. It is to be stored as the new
+	// cursor once the data is written.
+	AccessLogCursor *int64
+}
+
+// Data is the payload of one subtype of an asset. Data without timestamp is written as current data.
+type Data struct {
+	Subtype   api.DataSubtype
+	Timestamp time.Time
+	Payload   any
+}
+
+// AlarmRule raises an alarm as soon as the digital status attribute switches to 1.
+type AlarmRule struct {
+	Attribute string
+	Priority  api.AlarmPriority
+	Message   map[string]string
+}
+
+var drivers []Driver
+
+// Register adds a driver to the registry. Drivers are registered in the init functions of their files.
+func Register(driver Driver) {
+	drivers = append(drivers, driver)
+}
+
+// Drivers returns all registered drivers.
+func Drivers() []Driver {
+	return drivers
+}
+
+// LookupDriver returns the driver handling the device type.
+func LookupDriver(typ int) (Driver, error) {
+	// TODO: Verify that this is the correct property to determine device type.
+	for _, driver := range drivers {
+		for _, id := range driver.TypeIds() {
+			if id == typ {
+				return driver, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("unknown device type: %v", typ)
+}
+
+// deviceAsset returns the asset of the device itself with the given data.
+func deviceAsset(device DeviceInfo, data ...Data) Asset {
+	return Asset{
+		Identifier:  device.Serial,
+		AssetType:   device.AssetType,
+		Name:        fmt.Sprintf("%s (%s)", device.Name, device.IPAddress),
+		Description: fmt.Sprintf("%s (%s)", device.Name, device.Serial),
+		Data:        data,
+	}
+}
+
+// BoolToInt converts a boolean to the 0 or 1 Eliona uses for boolean attributes.
+func BoolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -16,11 +16,17 @@
 package kentix
 
 import (
+	"context"
 	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"kentix/apiserver"
+	"net"
 	"net/url"
-	"time"
+	"sort"
+	"strconv"
+	"sync"
 
 	"github.com/eliona-smart-building-assistant/go-utils/http"
 )
@@ -29,7 +35,12 @@ const (
 	AccessPointAssetType  = "kentix_access_manager"
 	AlarmManagerAssetType = "kentix_alarm_manager"
 	MultiSensorAssetType  = "kentix_multi_sensor"
+	SmartXScanAssetType   = "kentix_smart_x_scan"
 	DoorlockAssetType     = "kentix_doorlock"
+	DigitalInputAssetType = "kentix_digital_input"
+	AlarmZoneAssetType    = "kentix_alarm_zone"
+	AlarmSensorAssetType  = "kentix_alarm_sensor"
+	AccessLogAssetType    = "kentix_access_log"
 )
 
 type infoResponse struct {
@@ -62,7 +73,20 @@ type MasterSlave struct {
 	MasterIP string `json:"master_ip"`
 }
 
-func GetDeviceInfo(conf apiserver.Configuration) (*DeviceInfo, error) {
+// IsMaster tells whether the device is the master of a master/slave setup. Devices outside of such a
+// setup report no master IP, a master reports its own listen address instead, e.g. 0.0.0.0.
+func (m MasterSlave) IsMaster() bool {
+	return !m.IsSlave && m.MasterIP != ""
+}
+
+func GetDeviceInfo(ctx context.Context, conf apiserver.Configuration) (*DeviceInfo, error) {
+	return getDeviceInfo(ctx, conf, true)
+}
+
+func getDeviceInfo(ctx context.Context, conf apiserver.Configuration, retry bool) (*DeviceInfo, error) {
+	if !retry {
+		ctx = withoutRetries(ctx)
+	}
 	url, err := url.JoinPath(conf.Address, "api/info")
 	if err != nil {
 		return nil, fmt.Errorf("appending endpoint to URL: %v", err)
@@ -71,33 +95,64 @@ func GetDeviceInfo(conf apiserver.Configuration) (*DeviceInfo, error) {
 	if err != nil {
 		return nil, fmt.Errorf("creating request to %s: %v", url, err)
 	}
-	infoResponse, err := http.Read[infoResponse](r, time.Duration(*conf.RequestTimeout)*time.Second, false)
+	infoResponse, err := read[infoResponse](ctx, conf, r)
 	if err != nil {
 		return nil, fmt.Errorf("reading response from %s: %v", url, err)
 	}
-	infoResponse.Data.AssetType, err = inferAssetType(infoResponse.Data.Type)
+	driver, err := LookupDriver(infoResponse.Data.Type)
 	if err != nil {
 		return nil, fmt.Errorf("inferring asset type from %s: %v", url, err)
 	}
+	infoResponse.Data.AssetType = driver.AssetType()
 	return &infoResponse.Data, nil
 }
 
-func inferAssetType(typ int) (string, error) {
-	// TODO: Verify that this is the correct property to determine device type.
-	switch typ {
-	case 1:
-		return AccessPointAssetType, nil
-	case 8:
-		return AlarmManagerAssetType, nil
-	case 110:
-		return MultiSensorAssetType, nil
+// ErrNotFound is returned if a device doesn't know the doorlock or zone a command is sent to, e.g.
+// because it belongs to another device of the master/slave setup.
+var ErrNotFound = errors.New("not found")
+
+// ErrNotSupported is returned if a device doesn't provide an endpoint, e.g. because an optional
+// feature is not installed. Readers of optional features return it, so that the connection test
+// skips them for devices without the feature.
+var ErrNotSupported = errors.New("not supported by the device")
+
+// Slave is a device connected to a master in a Kentix master/slave setup.
+type Slave struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	IPAddress string `json:"ip_address"`
+	Serial    string `json:"serial"`
+	Type      int    `json:"type"`
+}
+
+// GetSlaves reads the slaves registered on a master device.
+func GetSlaves(ctx context.Context, conf apiserver.Configuration) ([]Slave, error) {
+	url, err := url.JoinPath(conf.Address, "api/masterslave/slaves")
+	if err != nil {
+		return nil, fmt.Errorf("appending endpoint to URL: %v", err)
 	}
-	return "", fmt.Errorf("unknown device type: %v", typ)
+	return fetchPaginated[Slave](ctx, url, conf, 0)
 }
 
-type accessPointResponse struct {
-	Data  []DoorLock     `json:"data"`
-	Links PaginationLink `json:"links"`
+// SlaveConfiguration derives the configuration to access a slave from the configuration of its
+// master. Slaves share the API key and the settings of the master. The pinned certificate is the one
+// of the master, so slaves of a pinned master pin their own certificate on first use. The master
+// vouches for them, as the slaves are listed by the pinned master.
+func SlaveConfiguration(conf apiserver.Configuration, slave Slave) (apiserver.Configuration, error) {
+	u, err := url.Parse(conf.Address)
+	if err != nil {
+		return apiserver.Configuration{}, fmt.Errorf("parsing address: %v", err)
+	}
+	if port := u.Port(); port != "" {
+		u.Host = net.JoinHostPort(slave.IPAddress, port)
+	} else {
+		u.Host = slave.IPAddress
+	}
+	conf.Address = u.String()
+	pinned := conf.CertificateFingerprint != nil && *conf.CertificateFingerprint != ""
+	conf.CertificateFingerprint = nil
+	conf.TrustOnFirstUse = &pinned
+	return conf, nil
 }
 
 type DoorLock struct {
@@ -125,32 +180,285 @@ type PaginationLink struct {
 	Next string `json:"next"`
 }
 
-func GetAccessPointReadings(conf apiserver.Configuration) ([]DoorLock, error) {
+type paginatedResponse[T any] struct {
+	Data  []T            `json:"data"`
+	Links PaginationLink `json:"links"`
+}
+
+func GetAccessPointReadings(ctx context.Context, conf apiserver.Configuration) ([]DoorLock, error) {
 	url, err := url.JoinPath(conf.Address, "api/devices/doorlocks")
 	if err != nil {
 		return nil, fmt.Errorf("appending endpoint to URL: %v", err)
 	}
-	return fetchDoorlocks(url, conf)
+	return fetchPaginated[DoorLock](ctx, url, conf, 0)
+}
+
+// fetchPaginated reads the pages of a list endpoint by following the links to the next page. Once
+// at least limit items are read, no further pages are requested. A limit of 0 reads all pages.
+func fetchPaginated[T any](ctx context.Context, url string, conf apiserver.Configuration, limit int) ([]T, error) {
+	var items []T
+	for url != "" {
+		r, err := http.NewRequestWithApiKey(url, "Authorization", "Basic "+authKey(conf.ApiKey))
+		if err != nil {
+			return nil, fmt.Errorf("creating request to %s: %v", url, err)
+		}
+		response, err := read[paginatedResponse[T]](ctx, conf, r)
+		if err != nil {
+			return nil, fmt.Errorf("reading response from %s: %v", url, err)
+		}
+		items = append(items, response.Data...)
+		if limit > 0 && len(items) >= limit {
+			break
+		}
+		url = response.Links.Next
+	}
+	return items, nil
+}
+
+type openDoorlockRequest struct {
+	Duration int `json:"duration"`
+}
+
+// OpenDoorlock opens the doorlock with the given serial number for its configured couple time.
+func OpenDoorlock(ctx context.Context, conf apiserver.Configuration, serial string) error {
+	doorlocks, err := GetAccessPointReadings(ctx, conf)
+	if err != nil {
+		return fmt.Errorf("getting doorlocks: %v", err)
+	}
+	for _, doorlock := range doorlocks {
+		if doorlock.Serial != serial {
+			continue
+		}
+		url, err := url.JoinPath(conf.Address, "api/devices/doorlocks", strconv.Itoa(doorlock.ID), "open")
+		if err != nil {
+			return fmt.Errorf("appending endpoint to URL: %v", err)
+		}
+		r, err := http.NewPostRequestWithApiKey(url, openDoorlockRequest{Duration: doorlock.CoupleTime}, "Authorization", "Basic "+authKey(conf.ApiKey))
+		if err != nil {
+			return fmt.Errorf("creating request to %s: %v", url, err)
+		}
+		if _, err := do(ctx, conf, r); err != nil {
+			return fmt.Errorf("opening doorlock %d: %v", doorlock.ID, err)
+		}
+		return nil
+	}
+	return fmt.Errorf("doorlock '%s': %w", serial, ErrNotFound)
+}
+
+// DoorlockValues are the live values of a doorlock. Values the doorlock does not provide are nil.
+type DoorlockValues struct {
+	ID          int  `json:"id"`
+	DoorContact *int `json:"door_contact"`
+	LockState   *int `json:"lock_state"`
+	Battery     *int `json:"battery"`
+}
+
+type doorlockValuesResponse struct {
+	Data DoorlockValues `json:"data"`
 }
 
-func fetchDoorlocks(url string, conf apiserver.Configuration) ([]DoorLock, error) {
+// maxConcurrentDoorlockRequests limits the number of parallel requests to one Access Manager.
+const maxConcurrentDoorlockRequests = 4
+
+// GetDoorlockValues reads the live values of the given doorlocks concurrently. The returned slices
+// have the same order as the doorlocks passed in. A doorlock which doesn't answer, e.g. because its
+// battery is empty, only fails itself: its values are nil and its error is set. The error is only
+// returned if reading was cancelled.
+func GetDoorlockValues(ctx context.Context, conf apiserver.Configuration, doorlocks []DoorLock) ([]*DoorlockValues, []error, error) {
+	values := make([]*DoorlockValues, len(doorlocks))
+	errs := make([]error, len(doorlocks))
+	semaphore := make(chan struct{}, maxConcurrentDoorlockRequests)
+	var wg sync.WaitGroup
+	for i, doorlock := range doorlocks {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return values, errs, ctx.Err()
+		}
+		wg.Add(1)
+		go func(i int, doorlock DoorLock) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			v, err := fetchDoorlockValues(ctx, conf, doorlock.ID)
+			if err != nil {
+				errs[i] = fmt.Errorf("fetching values of doorlock %d: %v", doorlock.ID, err)
+				return
+			}
+			values[i] = v
+		}(i, doorlock)
+	}
+	wg.Wait()
+	return values, errs, ctx.Err()
+}
+
+func fetchDoorlockValues(ctx context.Context, conf apiserver.Configuration, id int) (*DoorlockValues, error) {
+	url, err := url.JoinPath(conf.Address, "api/devices/doorlocks", strconv.Itoa(id), "values")
+	if err != nil {
+		return nil, fmt.Errorf("appending endpoint to URL: %v", err)
+	}
 	r, err := http.NewRequestWithApiKey(url, "Authorization", "Basic "+authKey(conf.ApiKey))
 	if err != nil {
 		return nil, fmt.Errorf("creating request to %s: %v", url, err)
 	}
-	accessPointResponse, err := http.Read[accessPointResponse](r, time.Duration(*conf.RequestTimeout)*time.Second, false)
+	doorlockValuesResponse, err := read[doorlockValuesResponse](ctx, conf, r)
 	if err != nil {
 		return nil, fmt.Errorf("reading response from %s: %v", url, err)
 	}
-	doorlocks := accessPointResponse.Data
-	if accessPointResponse.Links.Next != "" {
-		dl, err := fetchDoorlocks(accessPointResponse.Links.Next, conf)
-		if err != nil {
-			return nil, err
+	return &doorlockValuesResponse.Data, nil
+}
+
+type AlarmZone struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Armed    Flag   `json:"armed"`
+	HasAlarm Flag   `json:"has_alarm"`
+}
+
+// GetAlarmZones reads all alarm zones of an AlarmManager.
+func GetAlarmZones(ctx context.Context, conf apiserver.Configuration) ([]AlarmZone, error) {
+	url, err := url.JoinPath(conf.Address, "api/alarmzones")
+	if err != nil {
+		return nil, fmt.Errorf("appending endpoint to URL: %v", err)
+	}
+	return fetchPaginated[AlarmZone](ctx, url, conf, 0)
+}
+
+// SetAlarmZoneArmed arms or disarms the alarm zone with the given ID.
+func SetAlarmZoneArmed(ctx context.Context, conf apiserver.Configuration, zoneId int, armed bool) error {
+	action := "disarm"
+	if armed {
+		action = "arm"
+	}
+	url, err := url.JoinPath(conf.Address, "api/alarmzones", strconv.Itoa(zoneId), action)
+	if err != nil {
+		return fmt.Errorf("appending endpoint to URL: %v", err)
+	}
+	r, err := http.NewPostRequestWithApiKey(url, struct{}{}, "Authorization", "Basic "+authKey(conf.ApiKey))
+	if err != nil {
+		return fmt.Errorf("creating request to %s: %v", url, err)
+	}
+	if _, err := do(ctx, conf, r); err != nil {
+		return fmt.Errorf("requesting %s of alarm zone %d: %v", action, zoneId, err)
+	}
+	return nil
+}
+
+// AlarmSensor is a radio or LAN sensor connected to an AlarmManager, e.g. a door/window contact,
+// motion detector or smoke detector.
+type AlarmSensor struct {
+	ID         int         `json:"id"`
+	Name       string      `json:"name"`
+	Serial     string      `json:"serial"`
+	Type       string      `json:"type"`
+	Connection string      `json:"connection"`
+	State      SensorState `json:"state"`
+	Battery    *int        `json:"battery"`
+}
+
+// GetAlarmSensors reads all sensors connected to an AlarmManager.
+func GetAlarmSensors(ctx context.Context, conf apiserver.Configuration) ([]AlarmSensor, error) {
+	url, err := url.JoinPath(conf.Address, "api/devices/sensors")
+	if err != nil {
+		return nil, fmt.Errorf("appending endpoint to URL: %v", err)
+	}
+	return fetchPaginated[AlarmSensor](ctx, url, conf, 0)
+}
+
+// AccessEvent is an entry in the access log of an Access Manager.
+type AccessEvent struct {
+	ID             int64  `json:"id"`
+	Timestamp      int64  `json:"timestamp"`
+	User           string `json:"user"`
+	Medium         string `json:"medium"`
+	DoorlockSerial string `json:"doorlock_serial"`
+	DoorlockName   string `json:"doorlock_name"`
+	Result         string `json:"result"`
+}
+
+// GetAccessEvents reads the access events newer than the event with the given ID, ordered from the
+// oldest to the newest event. Once at least limit events are read, no further pages are requested,
+// so more events than the limit may be returned. A limit of 0 reads all events.
+func GetAccessEvents(ctx context.Context, conf apiserver.Configuration, afterId int64, limit int) ([]AccessEvent, error) {
+	u, err := url.Parse(conf.Address)
+	if err != nil {
+		return nil, fmt.Errorf("parsing address: %v", err)
+	}
+	u = u.JoinPath("api/logs/access")
+	u.RawQuery = url.Values{"after_id": {strconv.FormatInt(afterId, 10)}}.Encode()
+	events, err := fetchPaginated[AccessEvent](ctx, u.String(), conf, limit)
+	if err != nil {
+		return nil, err
+	}
+	// Older firmware ignores the filter, so the events are filtered here once more. As only the
+	// first pages are read, such firmware only delivers the events within the limit.
+	var newEvents []AccessEvent
+	for _, event := range events {
+		if event.ID > afterId {
+			newEvents = append(newEvents, event)
 		}
-		doorlocks = append(doorlocks, dl...)
 	}
-	return doorlocks, nil
+	sort.Slice(newEvents, func(i, j int) bool {
+		return newEvents[i].ID < newEvents[j].ID
+	})
+	return newEvents, nil
+}
+
+type Webhook struct {
+	ID      int               `json:"id"`
+	Name    string            `json:"name"`
+	URL     string            `json:"url"`
+	Method  string            `json:"method"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+type webhookResponse struct {
+	Data Webhook `json:"data"`
+}
+
+// CreateWebhook registers a webhook on the device, which calls the given URL with the given headers
+// on every event.
+func CreateWebhook(ctx context.Context, conf apiserver.Configuration, name string, callbackUrl string, headers map[string]string) (*Webhook, error) {
+	url, err := url.JoinPath(conf.Address, "api/webhooks")
+	if err != nil {
+		return nil, fmt.Errorf("appending endpoint to URL: %v", err)
+	}
+	webhook := Webhook{
+		Name:    name,
+		URL:     callbackUrl,
+		Method:  "POST",
+		Headers: headers,
+	}
+	r, err := http.NewPostRequestWithApiKey(url, webhook, "Authorization", "Basic "+authKey(conf.ApiKey))
+	if err != nil {
+		return nil, fmt.Errorf("creating request to %s: %v", url, err)
+	}
+	webhookResponse, err := read[webhookResponse](ctx, conf, r)
+	if err != nil {
+		return nil, fmt.Errorf("reading response from %s: %v", url, err)
+	}
+	return &webhookResponse.Data, nil
+}
+
+// DeleteWebhook removes the webhook from the device. A webhook which doesn't exist anymore is
+// considered as removed.
+func DeleteWebhook(ctx context.Context, conf apiserver.Configuration, webhookId int) error {
+	url, err := url.JoinPath(conf.Address, "api/webhooks", strconv.Itoa(webhookId))
+	if err != nil {
+		return fmt.Errorf("appending endpoint to URL: %v", err)
+	}
+	r, err := http.NewDeleteRequestWithApiKey(url, "Authorization", "Basic "+authKey(conf.ApiKey))
+	if err != nil {
+		return fmt.Errorf("creating request to %s: %v", url, err)
+	}
+	_, statusCode, err := doWithStatusCode(ctx, conf, r)
+	if err != nil {
+		return fmt.Errorf("requesting %s: %v", url, err)
+	}
+	if statusCode >= 300 && statusCode != 404 {
+		return fmt.Errorf("deleting webhook %d: status code %d", webhookId, statusCode)
+	}
+	return nil
 }
 
 type DigitalInput struct {
@@ -158,19 +466,75 @@ type DigitalInput struct {
 	Name  string `json:"name"`
 	Input struct {
 		Value      string `json:"value"`
-		HasAlarm   bool   `json:"has_alarm"`
+		HasAlarm   Flag   `json:"has_alarm"`
 		Unit       string `json:"unit"`
 		UnitPrefix string `json:"unit_prefix"`
 	} `json:"input"`
 }
 
+var unitPrefixFactors = map[string]float64{
+	"":  1,
+	"n": 1e-9,
+	"u": 1e-6,
+	"µ": 1e-6,
+	"m": 1e-3,
+	"c": 1e-2,
+	"d": 1e-1,
+	"h": 1e2,
+	"k": 1e3,
+	"M": 1e6,
+	"G": 1e9,
+}
+
+// ScaledValue returns the value of the input converted from the unit prefix to the base unit.
+func (d DigitalInput) ScaledValue() (float64, error) {
+	if d.Input.Value == "" {
+		return 0, nil
+	}
+	value, err := strconv.ParseFloat(d.Input.Value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parsing value '%s': %v", d.Input.Value, err)
+	}
+	factor, ok := unitPrefixFactors[d.Input.UnitPrefix]
+	if !ok {
+		return 0, fmt.Errorf("unknown unit prefix '%s'", d.Input.UnitPrefix)
+	}
+	return value * factor, nil
+}
+
+// Flag is a boolean which Kentix devices report either as JSON boolean or as numeric string,
+// where "-1" means that the feature is not configured.
+type Flag bool
+
+func (f *Flag) UnmarshalJSON(data []byte) error {
+	var b bool
+	if err := json.Unmarshal(data, &b); err == nil {
+		*f = Flag(b)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("unmarshaling flag %s: %v", data, err)
+	}
+	switch s {
+	case "1", "true":
+		*f = true
+	case "", "0", "-1", "false":
+		*f = false
+	default:
+		return fmt.Errorf("unknown flag value '%s'", s)
+	}
+	return nil
+}
+
 type SensorState struct {
 	HasAlarm bool `json:"has_alarm"`
 }
 
 type SensorValue struct {
-	Value    string `json:"value"`
-	HasAlarm bool   `json:"has_alarm"`
+	Value      string `json:"value"`
+	HasAlarm   bool   `json:"has_alarm"`
+	HasWarning bool   `json:"has_warning"`
 }
 
 type SensorData struct {
@@ -189,13 +553,29 @@ type SensorData struct {
 	Motion      SensorValue `json:"motion"`
 	Vibration   SensorValue `json:"vibration"`
 	PeopleCount SensorValue `json:"people_count"`
+
+	PowerSabotage      SensorState `json:"power_sabotage"`
+	ConnectionSabotage SensorState `json:"connection_sabotage"`
+	InternalSabotage   SensorState `json:"internal_sabotage"`
+
+	DigitalInputs []DigitalInput `json:"digital_inputs"`
 }
 
 type sensorResponse struct {
 	Data SensorData `json:"data"`
 }
 
-func GetMultiSensorReadings(conf apiserver.Configuration) (*SensorData, error) {
+func GetMultiSensorReadings(ctx context.Context, conf apiserver.Configuration) (*SensorData, error) {
+	return fetchSensorValues(ctx, conf)
+}
+
+// GetSmartXScanReadings reads the current values of a SmartXScan. The device reports through the
+// same endpoint and format as the MultiSensor, but provides only a subset of the measurements.
+func GetSmartXScanReadings(ctx context.Context, conf apiserver.Configuration) (*SensorData, error) {
+	return fetchSensorValues(ctx, conf)
+}
+
+func fetchSensorValues(ctx context.Context, conf apiserver.Configuration) (*SensorData, error) {
 	url, err := url.JoinPath(conf.Address, "api/devices/multisensor/values")
 	if err != nil {
 		return nil, fmt.Errorf("appending endpoint to URL: %v", err)
@@ -204,13 +584,45 @@ func GetMultiSensorReadings(conf apiserver.Configuration) (*SensorData, error) {
 	if err != nil {
 		return nil, fmt.Errorf("creating request to %s: %v", url, err)
 	}
-	sensorResponse, err := http.Read[sensorResponse](r, time.Duration(*conf.RequestTimeout)*time.Second, false)
+	sensorResponse, err := read[sensorResponse](ctx, conf, r)
 	if err != nil {
 		return nil, fmt.Errorf("reading response from %s: %v", url, err)
 	}
 	return &sensorResponse.Data, nil
 }
 
+type EntryControlCounting struct {
+	Value    string `json:"value"`
+	HasAlarm bool   `json:"has_alarm"`
+	MaxCount int    `json:"max_count"`
+}
+
+type EntryControlData struct {
+	CO2      SensorValue          `json:"co2"`
+	Counting EntryControlCounting `json:"counting"`
+}
+
+type entryControlResponse struct {
+	Data EntryControlData `json:"data"`
+}
+
+// GetEntryControlReadings reads the people counting values of a MultiSensor used for entry control.
+func GetEntryControlReadings(ctx context.Context, conf apiserver.Configuration) (*EntryControlData, error) {
+	url, err := url.JoinPath(conf.Address, "api/entrycontrol/values")
+	if err != nil {
+		return nil, fmt.Errorf("appending endpoint to URL: %v", err)
+	}
+	r, err := http.NewRequestWithApiKey(url, "Authorization", "Basic "+authKey(conf.ApiKey))
+	if err != nil {
+		return nil, fmt.Errorf("creating request to %s: %v", url, err)
+	}
+	entryControlResponse, err := read[entryControlResponse](ctx, conf, r)
+	if err != nil {
+		return nil, fmt.Errorf("reading response from %s: %w", url, err)
+	}
+	return &entryControlResponse.Data, nil
+}
+
 func authKey(apiKey string) string {
 	return base64.StdEncoding.EncodeToString([]byte(apiKey + ":"))
 }
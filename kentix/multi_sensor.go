@@ -0,0 +1,329 @@
+//  This file is part of the eliona project.
+//  Copyright © 2023 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package kentix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kentix/apiserver"
+	"strconv"
+	"sync"
+
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// multiSensorDriver supports the MultiSensor with its digital inputs and entry control.
+type multiSensorDriver struct{}
+
+func init() {
+	Register(multiSensorDriver{})
+}
+
+func (multiSensorDriver) TypeIds() []int {
+	return []int{110}
+}
+
+func (multiSensorDriver) AssetType() string {
+	return MultiSensorAssetType
+}
+
+func (multiSensorDriver) AssetTypeFiles() []string {
+	return []string{
+		"eliona/asset-type-multi-sensor.json",
+		"eliona/asset-type-digital-input.json",
+	}
+}
+
+func (multiSensorDriver) Readers() []Reader {
+	return []Reader{
+		{"sensors", func(ctx context.Context, conf apiserver.Configuration) error {
+			_, err := GetMultiSensorReadings(ctx, conf)
+			return err
+		}},
+		{"entry control", func(ctx context.Context, conf apiserver.Configuration) error {
+			_, err := GetEntryControlReadings(ctx, conf)
+			return err
+		}},
+	}
+}
+
+func (multiSensorDriver) Collect(ctx context.Context, conf apiserver.Configuration, device DeviceInfo, state PollState) ([]Asset, error) {
+	sensor, err := GetMultiSensorReadings(ctx, conf)
+	if err != nil {
+		return nil, fmt.Errorf("getting MultiSensor readings: %v", err)
+	}
+	asset := deviceAsset(device, multiSensorData(*sensor)...)
+	asset.AlarmRules = sabotageAlarmRules
+
+	// Entry control is an optional feature of the MultiSensor. Devices without it are remembered,
+	// so that they are neither requested nor logged again on each poll.
+	if _, absent := entryControlAbsent.Load(device.Serial); !absent {
+		entryControl, err := GetEntryControlReadings(ctx, conf)
+		switch {
+		case errors.Is(err, ErrNotSupported):
+			log.Info("kentix", "MultiSensor '%s' has no entry control", device.Serial)
+			entryControlAbsent.Store(device.Serial, true)
+		case err != nil:
+			log.Warn("kentix", "getting EntryControl readings: %v", err)
+		default:
+			data, err := entryControlData(*entryControl)
+			if err != nil {
+				return []Asset{asset}, fmt.Errorf("mapping EntryControl readings: %v", err)
+			}
+			asset.Data = append(asset.Data, data)
+		}
+	}
+
+	inputs, err := digitalInputAssets(device, sensor.DigitalInputs)
+	assets := append([]Asset{asset}, inputs...)
+	if err != nil {
+		return assets, fmt.Errorf("collecting MultiSensor digital inputs: %v", err)
+	}
+	return assets, nil
+}
+
+// entryControlAbsent holds the serial numbers of the MultiSensors without entry control.
+var entryControlAbsent sync.Map
+
+var sabotageAlarmRules = []AlarmRule{
+	{
+		Attribute: "power_sabotage",
+		Priority:  api.ALARM_PRIORITY_HEIGHT,
+		Message: map[string]string{
+			"de": "Sabotage der Stromversorgung erkannt",
+			"en": "Power sabotage detected",
+		},
+	},
+	{
+		Attribute: "connection_sabotage",
+		Priority:  api.ALARM_PRIORITY_HEIGHT,
+		Message: map[string]string{
+			"de": "Sabotage der Verbindung erkannt",
+			"en": "Connection sabotage detected",
+		},
+	},
+	{
+		Attribute: "internal_sabotage",
+		Priority:  api.ALARM_PRIORITY_HEIGHT,
+		Message: map[string]string{
+			"de": "Sabotage am Gerät erkannt",
+			"en": "Internal sabotage detected",
+		},
+	},
+}
+
+type sensorDataPayload struct {
+	Temperature    string `json:"temperature"`
+	Humidity       string `json:"humidity"`
+	DewPoint       string `json:"dew_point"`
+	AirPressure    string `json:"air_pressure"`
+	AirQuality     string `json:"air_quality"`
+	CO2            string `json:"co2"`
+	CO             string `json:"co"`
+	Heat           string `json:"heat"`
+	ThermalImaging string `json:"ti"`
+	Motion         string `json:"motion"`
+	Vibration      string `json:"vibration"`
+	PeopleCount    string `json:"people_count"`
+}
+
+type sensorStatusPayload struct {
+	Alarm int `json:"alarm"`
+
+	TemperatureAlarm      int `json:"temperature_alarm"`
+	TemperatureWarning    int `json:"temperature_warning"`
+	HumidityAlarm         int `json:"humidity_alarm"`
+	HumidityWarning       int `json:"humidity_warning"`
+	DewPointAlarm         int `json:"dew_point_alarm"`
+	DewPointWarning       int `json:"dew_point_warning"`
+	AirPressureAlarm      int `json:"air_pressure_alarm"`
+	AirPressureWarning    int `json:"air_pressure_warning"`
+	AirQualityAlarm       int `json:"air_quality_alarm"`
+	AirQualityWarning     int `json:"air_quality_warning"`
+	CO2Alarm              int `json:"co2_alarm"`
+	CO2Warning            int `json:"co2_warning"`
+	COAlarm               int `json:"co_alarm"`
+	COWarning             int `json:"co_warning"`
+	HeatAlarm             int `json:"heat_alarm"`
+	HeatWarning           int `json:"heat_warning"`
+	ThermalImagingAlarm   int `json:"ti_alarm"`
+	ThermalImagingWarning int `json:"ti_warning"`
+	MotionAlarm           int `json:"motion_alarm"`
+	MotionWarning         int `json:"motion_warning"`
+	VibrationAlarm        int `json:"vibration_alarm"`
+	VibrationWarning      int `json:"vibration_warning"`
+	PeopleCountAlarm      int `json:"people_count_alarm"`
+	PeopleCountWarning    int `json:"people_count_warning"`
+
+	PowerSabotage      int `json:"power_sabotage"`
+	ConnectionSabotage int `json:"connection_sabotage"`
+	InternalSabotage   int `json:"internal_sabotage"`
+}
+
+func multiSensorData(sensorData SensorData) []Data {
+	return []Data{
+		{
+			Subtype: api.SUBTYPE_INPUT,
+			Payload: sensorDataPayload{
+				Temperature:    sensorData.Temperature.Value,
+				Humidity:       sensorData.Humidity.Value,
+				DewPoint:       sensorData.Dewpoint.Value,
+				AirPressure:    sensorData.AirPressure.Value,
+				AirQuality:     sensorData.AirQuality.Value,
+				CO2:            sensorData.CO2.Value,
+				CO:             sensorData.CO.Value,
+				Heat:           sensorData.Heat.Value,
+				ThermalImaging: sensorData.TI.Value,
+				Motion:         sensorData.Motion.Value,
+				Vibration:      sensorData.Vibration.Value,
+				PeopleCount:    sensorData.PeopleCount.Value,
+			},
+		},
+		{
+			Subtype: api.SUBTYPE_STATUS,
+			Payload: sensorStatusPayload{
+				Alarm: BoolToInt(sensorData.State.HasAlarm),
+
+				TemperatureAlarm:      BoolToInt(sensorData.Temperature.HasAlarm),
+				TemperatureWarning:    BoolToInt(sensorData.Temperature.HasWarning),
+				HumidityAlarm:         BoolToInt(sensorData.Humidity.HasAlarm),
+				HumidityWarning:       BoolToInt(sensorData.Humidity.HasWarning),
+				DewPointAlarm:         BoolToInt(sensorData.Dewpoint.HasAlarm),
+				DewPointWarning:       BoolToInt(sensorData.Dewpoint.HasWarning),
+				AirPressureAlarm:      BoolToInt(sensorData.AirPressure.HasAlarm),
+				AirPressureWarning:    BoolToInt(sensorData.AirPressure.HasWarning),
+				AirQualityAlarm:       BoolToInt(sensorData.AirQuality.HasAlarm),
+				AirQualityWarning:     BoolToInt(sensorData.AirQuality.HasWarning),
+				CO2Alarm:              BoolToInt(sensorData.CO2.HasAlarm),
+				CO2Warning:            BoolToInt(sensorData.CO2.HasWarning),
+				COAlarm:               BoolToInt(sensorData.CO.HasAlarm),
+				COWarning:             BoolToInt(sensorData.CO.HasWarning),
+				HeatAlarm:             BoolToInt(sensorData.Heat.HasAlarm),
+				HeatWarning:           BoolToInt(sensorData.Heat.HasWarning),
+				ThermalImagingAlarm:   BoolToInt(sensorData.TI.HasAlarm),
+				ThermalImagingWarning: BoolToInt(sensorData.TI.HasWarning),
+				MotionAlarm:           BoolToInt(sensorData.Motion.HasAlarm),
+				MotionWarning:         BoolToInt(sensorData.Motion.HasWarning),
+				VibrationAlarm:        BoolToInt(sensorData.Vibration.HasAlarm),
+				VibrationWarning:      BoolToInt(sensorData.Vibration.HasWarning),
+				PeopleCountAlarm:      BoolToInt(sensorData.PeopleCount.HasAlarm),
+				PeopleCountWarning:    BoolToInt(sensorData.PeopleCount.HasWarning),
+
+				PowerSabotage:      BoolToInt(sensorData.PowerSabotage.HasAlarm),
+				ConnectionSabotage: BoolToInt(sensorData.ConnectionSabotage.HasAlarm),
+				InternalSabotage:   BoolToInt(sensorData.InternalSabotage.HasAlarm),
+			},
+		},
+	}
+}
+
+type entryControlDataPayload struct {
+	OccupancyCount      string  `json:"occupancy_count"`
+	OccupancyMax        int     `json:"occupancy_max"`
+	OccupancyPercentage float64 `json:"occupancy_percentage"`
+}
+
+func entryControlData(entryControl EntryControlData) (Data, error) {
+	percentage, err := occupancyPercentage(entryControl.Counting)
+	if err != nil {
+		return Data{}, fmt.Errorf("calculating occupancy percentage: %v", err)
+	}
+	return Data{
+		Subtype: api.SUBTYPE_INPUT,
+		Payload: entryControlDataPayload{
+			OccupancyCount:      entryControl.Counting.Value,
+			OccupancyMax:        entryControl.Counting.MaxCount,
+			OccupancyPercentage: percentage,
+		},
+	}, nil
+}
+
+// occupancyPercentage returns the current count relative to the maximum allowed occupancy. Without
+// a configured maximum, the percentage is reported as zero.
+func occupancyPercentage(counting EntryControlCounting) (float64, error) {
+	if counting.MaxCount <= 0 || counting.Value == "" {
+		return 0, nil
+	}
+	count, err := strconv.ParseFloat(counting.Value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parsing count '%s': %v", counting.Value, err)
+	}
+	return count / float64(counting.MaxCount) * 100, nil
+}
+
+type digitalInputInfoPayload struct {
+	Name string `json:"name"`
+	Unit string `json:"unit"`
+}
+
+type digitalInputDataPayload struct {
+	Value       string  `json:"value"`
+	ScaledValue float64 `json:"scaled_value"`
+}
+
+type digitalInputStatusPayload struct {
+	Alarm int `json:"alarm"`
+}
+
+// digitalInputAssets returns the assets of the digital inputs wired to a MultiSensor or SmartXScan.
+func digitalInputAssets(device DeviceInfo, inputs []DigitalInput) ([]Asset, error) {
+	var assets []Asset
+	for _, input := range inputs {
+		identifier := digitalInputIdentifier(device.Serial, input)
+		scaledValue, err := input.ScaledValue()
+		if err != nil {
+			return assets, fmt.Errorf("scaling value of input %s: %v", identifier, err)
+		}
+		assets = append(assets, Asset{
+			Identifier:  identifier,
+			AssetType:   DigitalInputAssetType,
+			Name:        fmt.Sprintf("%s %d", input.Name, input.ID),
+			Description: fmt.Sprintf("%s %d (%s)", input.Name, input.ID, device.Serial),
+			Parent:      device.Serial,
+			Data: []Data{
+				{
+					Subtype: api.SUBTYPE_INFO,
+					Payload: digitalInputInfoPayload{
+						Name: input.Name,
+						Unit: input.Input.Unit,
+					},
+				},
+				{
+					Subtype: api.SUBTYPE_INPUT,
+					Payload: digitalInputDataPayload{
+						Value:       input.Input.Value,
+						ScaledValue: scaledValue,
+					},
+				},
+				{
+					Subtype: api.SUBTYPE_STATUS,
+					Payload: digitalInputStatusPayload{
+						Alarm: BoolToInt(bool(input.Input.HasAlarm)),
+					},
+				},
+			},
+		})
+	}
+	return assets, nil
+}
+
+// digitalInputIdentifier identifies a digital input, which has no serial number on its own, by the
+// device it is wired to.
+func digitalInputIdentifier(deviceSerial string, input DigitalInput) string {
+	return fmt.Sprintf("%s_input_%d", deviceSerial, input.ID)
+}
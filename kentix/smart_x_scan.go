@@ -0,0 +1,91 @@
+//  This file is part of the eliona project.
+//  Copyright © 2023 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package kentix
+
+import (
+	"context"
+	"fmt"
+	"kentix/apiserver"
+
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+)
+
+// smartXScanDriver supports the SmartXScan with its digital inputs.
+type smartXScanDriver struct{}
+
+func init() {
+	Register(smartXScanDriver{})
+}
+
+func (smartXScanDriver) TypeIds() []int {
+	return []int{111}
+}
+
+func (smartXScanDriver) AssetType() string {
+	return SmartXScanAssetType
+}
+
+func (smartXScanDriver) AssetTypeFiles() []string {
+	return []string{
+		"eliona/asset-type-smart-x-scan.json",
+		"eliona/asset-type-digital-input.json",
+	}
+}
+
+func (smartXScanDriver) Readers() []Reader {
+	return []Reader{
+		{"sensors", func(ctx context.Context, conf apiserver.Configuration) error {
+			_, err := GetSmartXScanReadings(ctx, conf)
+			return err
+		}},
+	}
+}
+
+func (smartXScanDriver) Collect(ctx context.Context, conf apiserver.Configuration, device DeviceInfo, state PollState) ([]Asset, error) {
+	sensor, err := GetSmartXScanReadings(ctx, conf)
+	if err != nil {
+		return nil, fmt.Errorf("getting SmartXScan readings: %v", err)
+	}
+	asset := deviceAsset(device, Data{
+		Subtype: api.SUBTYPE_INPUT,
+		Payload: smartXScanDataPayload{
+			Temperature:    sensor.Temperature.Value,
+			Humidity:       sensor.Humidity.Value,
+			DewPoint:       sensor.Dewpoint.Value,
+			CO:             sensor.CO.Value,
+			ThermalImaging: sensor.TI.Value,
+			Motion:         sensor.Motion.Value,
+			Vibration:      sensor.Vibration.Value,
+		},
+	})
+
+	inputs, err := digitalInputAssets(device, sensor.DigitalInputs)
+	assets := append([]Asset{asset}, inputs...)
+	if err != nil {
+		return assets, fmt.Errorf("collecting SmartXScan digital inputs: %v", err)
+	}
+	return assets, nil
+}
+
+type smartXScanDataPayload struct {
+	Temperature    string `json:"temperature"`
+	Humidity       string `json:"humidity"`
+	DewPoint       string `json:"dew_point"`
+	CO             string `json:"co"`
+	ThermalImaging string `json:"ti"`
+	Motion         string `json:"motion"`
+	Vibration      string `json:"vibration"`
+}
@@ -0,0 +1,120 @@
+//  This file is part of the eliona project.
+//  Copyright © 2023 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package lease
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"kentix/conf"
+	"os"
+	"sync"
+	"time"
+)
+
+// Leases decides which configurations are polled by this app instance, if several instances of the
+// app run at the same time. An instance only polls a configuration while it holds the lease on it.
+// The leases are renewed as long as the instance runs, so the configurations of an instance which
+// died are taken over by the other instances once its leases expired.
+type Leases struct {
+	owner string
+	ttl   time.Duration
+	// onLost is called for configurations whose lease was taken over by another instance.
+	onLost func(configId int64)
+
+	mutex     sync.Mutex
+	renewedAt map[int64]time.Time
+	// checkedAt holds when the leases held by other instances were last tried to acquire.
+	checkedAt map[int64]time.Time
+}
+
+// New creates the leases of this app instance with the given time to live.
+func New(ttl time.Duration, onLost func(configId int64)) *Leases {
+	return &Leases{
+		owner:     instanceName(),
+		ttl:       ttl,
+		onLost:    onLost,
+		renewedAt: make(map[int64]time.Time),
+		checkedAt: make(map[int64]time.Time),
+	}
+}
+
+// Owner returns the name identifying this app instance as owner of leases.
+func (l *Leases) Owner() string {
+	return l.owner
+}
+
+// Own acquires the lease on the configuration and tells whether this instance owns it. Owned leases
+// are renewed after a third of their time to live, so they don't expire while the instance runs.
+// Leases held by another instance are tried to acquire at the same cadence, so that they are taken
+// over within a third of the time to live once released or expired.
+func (l *Leases) Own(ctx context.Context, configId int64) (bool, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	renewedAt, owned := l.renewedAt[configId]
+	if owned && time.Since(renewedAt) < l.ttl/3 {
+		return true, nil
+	}
+	if checkedAt, foreign := l.checkedAt[configId]; foreign && time.Since(checkedAt) < l.ttl/3 {
+		return false, nil
+	}
+	acquired, err := conf.AcquireLease(ctx, configId, l.owner, l.ttl)
+	if err != nil || !acquired {
+		// Without a renewed lease, another instance might take over anytime.
+		delete(l.renewedAt, configId)
+		if owned {
+			l.onLost(configId)
+		}
+		if err == nil {
+			l.checkedAt[configId] = time.Now()
+		}
+		return false, err
+	}
+	delete(l.checkedAt, configId)
+	l.renewedAt[configId] = time.Now()
+	return true, nil
+}
+
+// Release gives up the lease on the configuration, so another instance can take it over right away.
+func (l *Leases) Release(ctx context.Context, configId int64) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	delete(l.renewedAt, configId)
+	return conf.ReleaseLease(ctx, configId, l.owner)
+}
+
+// ReleaseAll gives up all leases of this instance, e.g. when it shuts down.
+func (l *Leases) ReleaseAll(ctx context.Context) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.renewedAt = make(map[int64]time.Time)
+	l.checkedAt = make(map[int64]time.Time)
+	return conf.ReleaseLeases(ctx, l.owner)
+}
+
+// instanceName combines the host name with a random suffix, so that it is unique even if several
+// instances run on the same host.
+func instanceName() string {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "kentix"
+	}
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return hostname
+	}
+	return hostname + "-" + hex.EncodeToString(suffix)
+}
@@ -27,6 +27,10 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
+// defaultDrainTimeout is the time given to running polls to finish on shutdown, if
+// SHUTDOWN_DRAIN_TIMEOUT is not set.
+const defaultDrainTimeout = 10 * time.Second
+
 // The main function starts the app by starting all services necessary for this app and waits
 // until all services are finished.
 func main() {
@@ -43,15 +47,37 @@ func main() {
 
 	initialization()
 
+	// The root context of all device requests, Eliona requests and database queries. It is
+	// cancelled once the app shuts down.
+	ctx, cancel := context.WithCancel(context.Background())
+	startScheduler(ctx)
+
 	common.WaitForWithOs(
-		common.Loop(collectData, time.Second),
+		common.LoopWithParam(collectData, ctx, time.Second),
+		func() { listenForOutputChanges(ctx) },
 		listenApiRequests,
 	)
 
-	// At the end set all configuration inactive
-	_, err := conf.SetAllConfigsInactive(context.Background())
+	// Waits for the running polls, so that they don't write anything after the configurations
+	// are set inactive.
+	drainTimeout, err := time.ParseDuration(common.Getenv("SHUTDOWN_DRAIN_TIMEOUT", defaultDrainTimeout.String()))
 	if err != nil {
-		log.Error("conf", "setting all configs inactive: %v", err)
+		log.Error("main", "Invalid SHUTDOWN_DRAIN_TIMEOUT, using %v: %v", defaultDrainTimeout, err)
+		drainTimeout = defaultDrainTimeout
+	}
+	if !pollScheduler.Shutdown(drainTimeout) {
+		log.Warn("main", "Cancelled polls still running after %v", drainTimeout)
+	}
+	cancel()
+
+	// At the end set the configurations of this instance inactive and hand them over to the other
+	// instances right away.
+	_, err = conf.SetOwnedConfigsInactive(context.Background(), pollLeases.Owner())
+	if err != nil {
+		log.Error("conf", "setting owned configs inactive: %v", err)
+	}
+	if err := pollLeases.ReleaseAll(context.Background()); err != nil {
+		log.Error("conf", "releasing leases: %v", err)
 	}
 
 	log.Info("main", "Terminating the app.")
@@ -0,0 +1,272 @@
+//  This file is part of the eliona project.
+//  Copyright © 2023 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"kentix/apiserver"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Scheduler polls configurations with a bounded number of workers. A configuration is polled again
+// its refresh interval after the previous poll finished. The first poll of a configuration is
+// delayed by a random offset within its refresh interval, so that the polls of many configurations
+// are spread instead of starting at once. Reloading a configuration cancels its running poll and
+// polls it again right away.
+type Scheduler struct {
+	workers int
+	poll    func(context.Context, apiserver.Configuration)
+
+	// ctx is the parent of all polls. Cancelling it aborts the running polls on shutdown.
+	ctx     context.Context
+	cancel  context.CancelFunc
+	running sync.WaitGroup
+
+	mutex   sync.Mutex
+	cond    *sync.Cond
+	stopped bool
+	entries map[int64]*entry
+	queue   []job
+	random  *rand.Rand
+	metrics Metrics
+}
+
+type entry struct {
+	nextRun time.Time
+	// pending is set while the configuration is queued or polled.
+	pending bool
+	// removed marks pending configurations which are no longer scheduled.
+	removed bool
+	// cancel cancels the running poll of the configuration.
+	cancel context.CancelFunc
+	// reloaded marks running polls cancelled because the configuration changed.
+	reloaded bool
+}
+
+type job struct {
+	config apiserver.Configuration
+	due    time.Time
+}
+
+// Metrics shows how well the workers keep up with the polls. The lag is the time a poll waited in
+// the queue after it was due.
+type Metrics struct {
+	Workers        int
+	Configurations int
+	Queued         int
+	Running        int
+	Polls          int64
+	LastLag        time.Duration
+	MaxLag         time.Duration
+	AverageLag     time.Duration
+	totalLag       time.Duration
+}
+
+// New creates a scheduler which polls with the given number of workers.
+func New(workers int, poll func(context.Context, apiserver.Configuration)) *Scheduler {
+	if workers < 1 {
+		workers = 1
+	}
+	s := &Scheduler{
+		workers: workers,
+		poll:    poll,
+		entries: make(map[int64]*entry),
+		random:  rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	s.cond = sync.NewCond(&s.mutex)
+	s.metrics.Workers = workers
+	return s
+}
+
+// Start starts the workers. The polls run with contexts derived from the given context.
+func (s *Scheduler) Start(ctx context.Context) {
+	s.ctx, s.cancel = context.WithCancel(ctx)
+	s.running.Add(s.workers)
+	for i := 0; i < s.workers; i++ {
+		go s.work()
+	}
+}
+
+// Shutdown stops starting new polls and gives the running polls the drain timeout to finish. Polls
+// still running after the timeout are cancelled. Shutdown returns once all workers have returned,
+// so nothing is written on behalf of a poll afterwards. It tells whether the polls finished in time.
+func (s *Scheduler) Shutdown(drainTimeout time.Duration) bool {
+	s.mutex.Lock()
+	s.stopped = true
+	s.queue = nil
+	s.metrics.Queued = 0
+	s.cond.Broadcast()
+	s.mutex.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		s.running.Wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+		s.cancel()
+		return true
+	case <-time.After(drainTimeout):
+	}
+	s.cancel()
+	<-drained
+	return false
+}
+
+// Schedule queues the configurations which are due. Configurations missing from the list are no
+// longer polled.
+func (s *Scheduler) Schedule(configs []apiserver.Configuration) {
+	now := time.Now()
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.stopped {
+		return
+	}
+
+	scheduled := make(map[int64]bool)
+	for _, config := range configs {
+		id := *config.Id
+		scheduled[id] = true
+		e, ok := s.entries[id]
+		if !ok {
+			e = &entry{nextRun: now.Add(s.jitter(config))}
+			s.entries[id] = e
+		}
+		e.removed = false
+		if e.pending || now.Before(e.nextRun) {
+			continue
+		}
+		e.pending = true
+		s.queue = append(s.queue, job{config: config, due: e.nextRun})
+		s.cond.Signal()
+	}
+	for id, e := range s.entries {
+		if scheduled[id] {
+			continue
+		}
+		if e.pending {
+			e.removed = true
+		} else {
+			delete(s.entries, id)
+		}
+	}
+	s.metrics.Configurations = len(scheduled)
+	s.metrics.Queued = len(s.queue)
+}
+
+// Reload cancels the running poll of the configuration and drops its queued poll, because both use
+// the old settings. The configuration is polled with its new settings as soon as it is scheduled
+// again, or not at all if it was deleted or disabled.
+func (s *Scheduler) Reload(configId int64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	e, ok := s.entries[configId]
+	if !ok {
+		return
+	}
+	for i, j := range s.queue {
+		if *j.config.Id == configId {
+			s.queue = append(s.queue[:i], s.queue[i+1:]...)
+			e.pending = false
+			break
+		}
+	}
+	if e.cancel != nil {
+		e.cancel()
+		e.reloaded = true
+	}
+	e.nextRun = time.Now()
+	s.metrics.Queued = len(s.queue)
+}
+
+// Metrics returns the current metrics of the scheduler.
+func (s *Scheduler) Metrics() Metrics {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	metrics := s.metrics
+	if metrics.Polls > 0 {
+		metrics.AverageLag = metrics.totalLag / time.Duration(metrics.Polls)
+	}
+	return metrics
+}
+
+func (s *Scheduler) work() {
+	defer s.running.Done()
+	for {
+		s.mutex.Lock()
+		for len(s.queue) == 0 && !s.stopped {
+			s.cond.Wait()
+		}
+		if s.stopped {
+			s.mutex.Unlock()
+			return
+		}
+		j := s.queue[0]
+		s.queue = s.queue[1:]
+		lag := time.Since(j.due)
+		ctx, cancel := context.WithCancel(s.ctx)
+		if e, ok := s.entries[*j.config.Id]; ok {
+			e.cancel = cancel
+		}
+		s.metrics.Queued = len(s.queue)
+		s.metrics.Running++
+		s.metrics.Polls++
+		s.metrics.LastLag = lag
+		s.metrics.totalLag += lag
+		if lag > s.metrics.MaxLag {
+			s.metrics.MaxLag = lag
+		}
+		s.mutex.Unlock()
+
+		s.poll(ctx, j.config)
+		cancel()
+
+		s.mutex.Lock()
+		s.metrics.Running--
+		id := *j.config.Id
+		if e, ok := s.entries[id]; ok {
+			switch {
+			case e.removed:
+				delete(s.entries, id)
+			case e.reloaded:
+				e.pending = false
+				e.reloaded = false
+				e.cancel = nil
+			default:
+				e.pending = false
+				e.cancel = nil
+				e.nextRun = time.Now().Add(refreshInterval(j.config))
+			}
+		}
+		s.mutex.Unlock()
+	}
+}
+
+// jitter returns a random offset within the refresh interval of the configuration.
+func (s *Scheduler) jitter(config apiserver.Configuration) time.Duration {
+	return time.Duration(s.random.Int63n(int64(refreshInterval(config))))
+}
+
+func refreshInterval(config apiserver.Configuration) time.Duration {
+	interval := time.Duration(config.RefreshInterval) * time.Second
+	if interval < time.Second {
+		return time.Second
+	}
+	return interval
+}
@@ -0,0 +1,323 @@
+//  This file is part of the eliona project.
+//  Copyright © 2023 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"kentix/apiserver"
+	"sync"
+	"testing"
+	"time"
+)
+
+const waitTimeout = 2 * time.Second
+
+func testConfig(id int64) apiserver.Configuration {
+	return apiserver.Configuration{Id: &id, RefreshInterval: 60}
+}
+
+// recorder is a poll function which records its polls. Polls block until they are released or their
+// context is cancelled.
+type recorder struct {
+	mutex     sync.Mutex
+	polls     []int64
+	cancelled []int64
+	started   chan int64
+	release   chan struct{}
+}
+
+func newRecorder() *recorder {
+	return &recorder{
+		started: make(chan int64, 100),
+		release: make(chan struct{}),
+	}
+}
+
+func (r *recorder) poll(ctx context.Context, config apiserver.Configuration) {
+	r.mutex.Lock()
+	r.polls = append(r.polls, *config.Id)
+	r.mutex.Unlock()
+	r.started <- *config.Id
+	select {
+	case <-r.release:
+	case <-ctx.Done():
+		r.mutex.Lock()
+		r.cancelled = append(r.cancelled, *config.Id)
+		r.mutex.Unlock()
+	}
+}
+
+func (r *recorder) pollCount() int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return len(r.polls)
+}
+
+func (r *recorder) cancelledCount() int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return len(r.cancelled)
+}
+
+func (r *recorder) waitStarted(t *testing.T, id int64) {
+	t.Helper()
+	select {
+	case started := <-r.started:
+		if started != id {
+			t.Fatalf("poll of config %d started, want %d", started, id)
+		}
+	case <-time.After(waitTimeout):
+		t.Fatalf("poll of config %d didn't start", id)
+	}
+}
+
+func (r *recorder) expectNoPoll(t *testing.T) {
+	t.Helper()
+	select {
+	case started := <-r.started:
+		t.Fatalf("unexpected poll of config %d", started)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// scheduleNow schedules the configurations as if their first poll was due, skipping the random
+// offset of the first poll.
+func scheduleNow(s *Scheduler, configs ...apiserver.Configuration) {
+	s.Schedule(configs)
+	s.mutex.Lock()
+	for _, config := range configs {
+		if e, ok := s.entries[*config.Id]; ok {
+			e.nextRun = time.Now().Add(-time.Millisecond)
+		}
+	}
+	s.mutex.Unlock()
+	s.Schedule(configs)
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(waitTimeout)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met in time")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func startScheduler(t *testing.T, workers int, r *recorder) *Scheduler {
+	t.Helper()
+	s := New(workers, r.poll)
+	s.Start(context.Background())
+	t.Cleanup(func() {
+		s.Shutdown(0)
+	})
+	return s
+}
+
+func TestFirstPollIsDelayedWithinRefreshInterval(t *testing.T) {
+	r := newRecorder()
+	s := startScheduler(t, 1, r)
+	s.Schedule([]apiserver.Configuration{testConfig(1)})
+
+	s.mutex.Lock()
+	delay := time.Until(s.entries[1].nextRun)
+	s.mutex.Unlock()
+	if delay < -time.Second || delay > 60*time.Second {
+		t.Fatalf("first poll delayed by %v, want within refresh interval", delay)
+	}
+}
+
+func TestPendingConfigurationIsNotQueuedTwice(t *testing.T) {
+	r := newRecorder()
+	s := startScheduler(t, 2, r)
+	config := testConfig(1)
+	scheduleNow(s, config)
+	r.waitStarted(t, 1)
+
+	s.Schedule([]apiserver.Configuration{config})
+	s.Schedule([]apiserver.Configuration{config})
+	r.expectNoPoll(t)
+	if got := r.pollCount(); got != 1 {
+		t.Fatalf("polled %d times, want 1", got)
+	}
+}
+
+func TestFinishedPollIsScheduledAfterRefreshInterval(t *testing.T) {
+	r := newRecorder()
+	s := startScheduler(t, 1, r)
+	config := testConfig(1)
+	scheduleNow(s, config)
+	r.waitStarted(t, 1)
+	r.release <- struct{}{}
+
+	waitFor(t, func() bool { return s.Metrics().Running == 0 })
+	s.Schedule([]apiserver.Configuration{config})
+	r.expectNoPoll(t)
+	s.mutex.Lock()
+	next := time.Until(s.entries[1].nextRun)
+	s.mutex.Unlock()
+	if next < 59*time.Second || next > 60*time.Second {
+		t.Fatalf("next poll in %v, want the refresh interval", next)
+	}
+}
+
+func TestRemovedConfigurationIsNoLongerPolled(t *testing.T) {
+	r := newRecorder()
+	s := startScheduler(t, 1, r)
+	config := testConfig(1)
+	scheduleNow(s, config)
+	r.waitStarted(t, 1)
+
+	s.Schedule(nil)
+	r.release <- struct{}{}
+	waitFor(t, func() bool { return s.Metrics().Running == 0 })
+	s.mutex.Lock()
+	_, ok := s.entries[1]
+	s.mutex.Unlock()
+	if ok {
+		t.Fatal("removed configuration is still scheduled")
+	}
+}
+
+func TestReload(t *testing.T) {
+	tests := []struct {
+		name string
+		// running lets the first configuration be polled when reloading, otherwise it is queued behind
+		// the blocking poll of another configuration.
+		running       bool
+		wantCancelled int
+	}{
+		{name: "cancels running poll and polls again", running: true, wantCancelled: 1},
+		{name: "drops queued poll and polls again", running: false, wantCancelled: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newRecorder()
+			s := startScheduler(t, 1, r)
+			config := testConfig(1)
+			if tt.running {
+				scheduleNow(s, config)
+				r.waitStarted(t, 1)
+			} else {
+				scheduleNow(s, testConfig(2))
+				r.waitStarted(t, 2)
+				scheduleNow(s, testConfig(2), config)
+				if queued := s.Metrics().Queued; queued != 1 {
+					t.Fatalf("%d polls queued, want 1", queued)
+				}
+			}
+
+			s.Reload(1)
+			if queued := s.Metrics().Queued; queued != 0 {
+				t.Fatalf("%d polls queued after reload, want 0", queued)
+			}
+			if tt.running {
+				waitFor(t, func() bool { return s.Metrics().Running == 0 })
+			} else {
+				r.release <- struct{}{}
+				waitFor(t, func() bool { return s.Metrics().Running == 0 })
+			}
+			if got := r.cancelledCount(); got != tt.wantCancelled {
+				t.Fatalf("%d polls cancelled, want %d", got, tt.wantCancelled)
+			}
+
+			// The reloaded configuration is due right away instead of after the refresh interval.
+			s.Schedule([]apiserver.Configuration{config})
+			r.waitStarted(t, 1)
+		})
+	}
+}
+
+func TestReloadOfUnknownConfigurationIsIgnored(t *testing.T) {
+	r := newRecorder()
+	s := startScheduler(t, 1, r)
+	s.Reload(42)
+	if metrics := s.Metrics(); metrics.Queued != 0 || metrics.Configurations != 0 {
+		t.Fatalf("unexpected metrics after reload: %+v", metrics)
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	tests := []struct {
+		name          string
+		drainTimeout  time.Duration
+		releaseAfter  time.Duration
+		wantDrained   bool
+		wantCancelled int
+	}{
+		{name: "waits for polls finishing within drain timeout", drainTimeout: waitTimeout, releaseAfter: 20 * time.Millisecond, wantDrained: true, wantCancelled: 0},
+		{name: "cancels polls exceeding drain timeout", drainTimeout: 20 * time.Millisecond, releaseAfter: -1, wantDrained: false, wantCancelled: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newRecorder()
+			s := New(1, r.poll)
+			s.Start(context.Background())
+			scheduleNow(s, testConfig(1), testConfig(2))
+			r.waitStarted(t, 1)
+			if tt.releaseAfter >= 0 {
+				time.AfterFunc(tt.releaseAfter, func() { r.release <- struct{}{} })
+			}
+
+			done := make(chan bool)
+			go func() { done <- s.Shutdown(tt.drainTimeout) }()
+			select {
+			case drained := <-done:
+				if drained != tt.wantDrained {
+					t.Fatalf("drained = %t, want %t", drained, tt.wantDrained)
+				}
+			case <-time.After(waitTimeout + time.Second):
+				t.Fatal("shutdown didn't return")
+			}
+			if got := r.cancelledCount(); got != tt.wantCancelled {
+				t.Fatalf("%d polls cancelled, want %d", got, tt.wantCancelled)
+			}
+			// The queued poll of the second configuration is dropped.
+			if got := r.pollCount(); got != 1 {
+				t.Fatalf("polled %d times, want 1", got)
+			}
+		})
+	}
+}
+
+func TestScheduleAfterShutdownIsIgnored(t *testing.T) {
+	r := newRecorder()
+	s := New(1, r.poll)
+	s.Start(context.Background())
+	s.Shutdown(0)
+
+	scheduleNow(s, testConfig(1))
+	r.expectNoPoll(t)
+	if queued := s.Metrics().Queued; queued != 0 {
+		t.Fatalf("%d polls queued after shutdown, want 0", queued)
+	}
+}
+
+func TestCancellingParentContextCancelsPolls(t *testing.T) {
+	r := newRecorder()
+	ctx, cancel := context.WithCancel(context.Background())
+	s := New(1, r.poll)
+	s.Start(ctx)
+	t.Cleanup(func() {
+		s.Shutdown(0)
+	})
+	scheduleNow(s, testConfig(1))
+	r.waitStarted(t, 1)
+
+	cancel()
+	waitFor(t, func() bool { return r.cancelledCount() == 1 })
+}
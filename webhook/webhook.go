@@ -0,0 +1,143 @@
+//  This file is part of the eliona project.
+//  Copyright © 2023 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"kentix/apiserver"
+	"kentix/conf"
+	"kentix/kentix"
+	"net/url"
+	"strconv"
+
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+const webhookName = "Eliona"
+
+// SecretHeader is the header in which the device sends the webhook secret. Unlike a query parameter,
+// it doesn't end up in proxy and access logs.
+const SecretHeader = "X-Webhook-Secret"
+
+// Register registers the webhook receiver of the app on the device of the configuration. If the
+// webhook is already registered with the same callback URL and secret, nothing is changed.
+func Register(ctx context.Context, config apiserver.Configuration) error {
+	baseUrl := common.Getenv("WEBHOOK_BASE_URL", "")
+	if baseUrl == "" {
+		log.Debug("webhook", "WEBHOOK_BASE_URL not set, skipping webhook registration for configuration %d", *config.Id)
+		return nil
+	}
+	if config.WebhookSecret == nil || *config.WebhookSecret == "" {
+		secret, err := newSecret()
+		if err != nil {
+			return fmt.Errorf("generating webhook secret: %v", err)
+		}
+		if err := conf.SetWebhookSecret(ctx, config, secret); err != nil {
+			return fmt.Errorf("storing webhook secret: %v", err)
+		}
+		config.WebhookSecret = &secret
+	}
+	callbackUrl, err := callbackUrl(baseUrl, config)
+	if err != nil {
+		return fmt.Errorf("building callback URL: %v", err)
+	}
+
+	registration, err := conf.GetWebhookRegistration(ctx, config)
+	if err != nil {
+		return fmt.Errorf("getting webhook registration: %v", err)
+	}
+	secretHash := hashSecret(*config.WebhookSecret)
+	if registration != nil && registration.URL == callbackUrl && registration.SecretHash == secretHash {
+		return nil
+	}
+	if registration != nil {
+		if err := kentix.DeleteWebhook(ctx, config, int(registration.WebhookID)); err != nil {
+			return fmt.Errorf("deleting outdated webhook: %v", err)
+		}
+	}
+
+	webhook, err := kentix.CreateWebhook(ctx, config, webhookName, callbackUrl, map[string]string{
+		SecretHeader: *config.WebhookSecret,
+	})
+	if err != nil {
+		return fmt.Errorf("creating webhook: %v", err)
+	}
+	if err := conf.SetWebhookRegistration(ctx, config, conf.WebhookRegistration{
+		WebhookID:  int32(webhook.ID),
+		URL:        callbackUrl,
+		SecretHash: secretHash,
+	}); err != nil {
+		return fmt.Errorf("storing webhook registration: %v", err)
+	}
+	log.Info("webhook", "Registered webhook %d for configuration %d", webhook.ID, *config.Id)
+	return nil
+}
+
+// Unregister removes the webhook registered by the app from the device of the configuration.
+func Unregister(ctx context.Context, config apiserver.Configuration) error {
+	registration, err := conf.GetWebhookRegistration(ctx, config)
+	if err != nil {
+		return fmt.Errorf("getting webhook registration: %v", err)
+	}
+	if registration == nil {
+		return nil
+	}
+	if err := kentix.DeleteWebhook(ctx, config, int(registration.WebhookID)); err != nil {
+		return fmt.Errorf("deleting webhook: %v", err)
+	}
+	if err := conf.DeleteWebhookRegistration(ctx, config); err != nil {
+		return fmt.Errorf("deleting webhook registration: %v", err)
+	}
+	log.Info("webhook", "Unregistered webhook %d for configuration %d", registration.WebhookID, *config.Id)
+	return nil
+}
+
+// Remove removes the given webhook from the device of the configuration, e.g. once the configuration
+// was deleted together with its webhook registration.
+func Remove(ctx context.Context, config apiserver.Configuration, registration conf.WebhookRegistration) error {
+	if err := kentix.DeleteWebhook(ctx, config, int(registration.WebhookID)); err != nil {
+		return fmt.Errorf("deleting webhook: %v", err)
+	}
+	log.Info("webhook", "Removed webhook %d of deleted configuration %d", registration.WebhookID, *config.Id)
+	return nil
+}
+
+func callbackUrl(baseUrl string, config apiserver.Configuration) (string, error) {
+	u, err := url.Parse(baseUrl)
+	if err != nil {
+		return "", err
+	}
+	u = u.JoinPath("v1/webhooks", strconv.FormatInt(*config.Id, 10))
+	return u.String(), nil
+}
+
+func hashSecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
+
+func newSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}